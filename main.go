@@ -1,9 +1,11 @@
 package main
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
@@ -13,6 +15,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 
 	"github.com/alecthomas/kingpin/v2"
@@ -24,16 +27,21 @@ import (
 	"go.uber.org/automaxprocs/maxprocs"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers"
+	analyzerconfig "github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/baseline"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/cache/simple"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/cleantemp"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/custom_detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/engine"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/engine/defaults"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/hook"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/output"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
@@ -55,6 +63,7 @@ var (
 	jsonOut             = cli.Flag("json", "Output in JSON format.").Short('j').Bool()
 	jsonLegacy          = cli.Flag("json-legacy", "Use the pre-v3.0 JSON format. Only works with git, gitlab, and github sources.").Bool()
 	gitHubActionsFormat = cli.Flag("github-actions", "Output in GitHub Actions format.").Bool()
+	sarifOut            = cli.Flag("sarif", "Output in SARIF 2.1.0 format.").Bool()
 	concurrency         = cli.Flag("concurrency", "Number of concurrent workers.").Default(strconv.Itoa(runtime.NumCPU())).Int()
 	noVerification      = cli.Flag("no-verification", "Don't verify the results.").Bool()
 	onlyVerified        = cli.Flag("only-verified", "Only output verified results.").Hidden().Bool()
@@ -65,6 +74,9 @@ var (
 	allowVerificationOverlap   = cli.Flag("allow-verification-overlap", "Allow verification of similar credentials across detectors").Bool()
 	filterUnverified           = cli.Flag("filter-unverified", "Only output first unverified result per chunk per detector if there are more than one results.").Bool()
 	filterEntropy              = cli.Flag("filter-entropy", "Filter unverified results with Shannon entropy. Start with 3.0.").Float64()
+	analyzeVerified            = cli.Flag("analyze-verified", "Analyze the permissions of verified credentials and include them in the JSON output. Write access is only probed with requests that are expected to be rejected.").Bool()
+	analyzeTimeout             = cli.Flag("analyze-timeout", "Maximum time to spend analyzing the permissions of a verified credential with --analyze-verified (e.g., 30s).").Default(engine.DefaultAnalyzeTimeout.String()).Duration()
+	maxDecodeDepth             = cli.Flag("max-decode-depth", "Maximum number of decoders applied on top of each other to find secrets in nested encodings, e.g. base64 inside base64. 1 decodes each chunk only once.").Default(strconv.Itoa(engine.DefaultMaxDecodeDepth)).Int()
 	scanEntireChunk            = cli.Flag("scan-entire-chunk", "Scan the entire chunk for secrets.").Hidden().Default("false").Bool()
 	compareDetectionStrategies = cli.Flag("compare-detection-strategies", "Compare different detection strategies for matching spans").Hidden().Default("false").Bool()
 	configFilename             = cli.Flag("config", "Path to configuration file.").ExistingFile()
@@ -81,27 +93,38 @@ var (
 	includeDetectors     = cli.Flag("include-detectors", "Comma separated list of detector types to include. Protobuf name or IDs may be used, as well as ranges.").Default("all").String()
 	excludeDetectors     = cli.Flag("exclude-detectors", "Comma separated list of detector types to exclude. Protobuf name or IDs may be used, as well as ranges. IDs defined here take precedence over the include list.").String()
 	jobReportFile        = cli.Flag("output-report", "Write a scan report to the provided path.").Hidden().OpenFile(os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0666)
+	checkpointFile       = cli.Flag("checkpoint-file", "Periodically save scan progress to this file and resume from it if it exists. The file is removed once the scan completes.").String()
+	checkpointInterval   = cli.Flag("checkpoint-interval", "How often to save scan progress to --checkpoint-file.").Default(sources.DefaultCheckpointInterval.String()).Duration()
 
 	noVerificationCache = cli.Flag("no-verification-cache", "Disable verification caching").Bool()
 
+	baselineFile  = cli.Flag("baseline", "Path to a baseline file. Findings recorded in the baseline are not reported.").String()
+	writeBaseline = cli.Flag("write-baseline", "Record all findings from this scan in the file given by --baseline instead of suppressing them.").Bool()
+
 	// Add feature flags
 	forceSkipBinaries  = cli.Flag("force-skip-binaries", "Force skipping binaries.").Bool()
 	forceSkipArchives  = cli.Flag("force-skip-archives", "Force skipping archives.").Bool()
 	skipAdditionalRefs = cli.Flag("skip-additional-refs", "Skip additional references.").Bool()
 	userAgentSuffix    = cli.Flag("user-agent-suffix", "Suffix to add to User-Agent.").String()
 
-	gitScan             = cli.Command("git", "Find credentials in git repositories.")
-	gitScanURI          = gitScan.Arg("uri", "Git repository URL. https://, file://, or ssh:// schema expected.").Required().String()
-	gitScanIncludePaths = gitScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
-	gitScanExcludePaths = gitScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
-	gitScanExcludeGlobs = gitScan.Flag("exclude-globs", "Comma separated list of globs to exclude in scan. This option filters at the `git log` level, resulting in faster scans.").String()
-	gitScanSinceCommit  = gitScan.Flag("since-commit", "Commit to start scan from.").String()
-	gitScanBranch       = gitScan.Flag("branch", "Branch to scan.").String()
-	gitScanMaxDepth     = gitScan.Flag("max-depth", "Maximum depth of commits to scan.").Int()
-	gitScanBare         = gitScan.Flag("bare", "Scan bare repository (e.g. useful while using in pre-receive hooks)").Bool()
-	_                   = gitScan.Flag("allow", "No-op flag for backwards compat.").Bool()
-	_                   = gitScan.Flag("entropy", "No-op flag for backwards compat.").Bool()
-	_                   = gitScan.Flag("regex", "No-op flag for backwards compat.").Bool()
+	gitScan                = cli.Command("git", "Find credentials in git repositories.")
+	gitScanURI             = gitScan.Arg("uri", "Git repository URL. https://, file://, or ssh:// schema expected.").Required().String()
+	gitScanIncludePaths    = gitScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	gitScanExcludePaths    = gitScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	gitScanExcludeGlobs    = gitScan.Flag("exclude-globs", "Comma separated list of globs to exclude in scan. This option filters at the `git log` level, resulting in faster scans.").String()
+	gitScanSinceCommit     = gitScan.Flag("since-commit", "Commit to start scan from.").String()
+	gitScanBranch          = gitScan.Flag("branch", "Branch to scan.").String()
+	gitScanMaxDepth        = gitScan.Flag("max-depth", "Maximum depth of commits to scan.").Int()
+	gitScanBare            = gitScan.Flag("bare", "Scan bare repository (e.g. useful while using in pre-receive hooks)").Bool()
+	gitScanRangesFile      = gitScan.Flag("ranges-file", "Path to a file with newline separated commit ranges to scan, such as main..feature, or - to read them from stdin. Commits shared between ranges are scanned once.").String()
+	gitScanIncludeTags     = gitScan.Flag("include-tags", "Also scan the messages of annotated tags.").Bool()
+	gitScanIncludeStashes  = gitScan.Flag("include-stashes", "Also scan stash entries.").Bool()
+	gitScanIncludeReflog   = gitScan.Flag("include-reflog", "Also scan commits that are only reachable from reflogs, such as those dropped by a rebase.").Bool()
+	gitScanIncludeDangling = gitScan.Flag("include-dangling", "Also scan dangling commits, blobs and tags, as recovered by git fsck --lost-found.").Bool()
+	gitScanStateFile       = gitScan.Flag("state-file", "Path to a file recording the last scanned commit of each repository and branch. Requires --branch. Only commits added since the last scan are scanned, and the file is updated once the scan completes without errors.").String()
+	_                      = gitScan.Flag("allow", "No-op flag for backwards compat.").Bool()
+	_                      = gitScan.Flag("entropy", "No-op flag for backwards compat.").Bool()
+	_                      = gitScan.Flag("regex", "No-op flag for backwards compat.").Bool()
 
 	githubScan                  = cli.Command("github", "Find credentials in GitHub repositories.")
 	githubScanEndpoint          = githubScan.Flag("endpoint", "GitHub endpoint.").Default("https://api.github.com").String()
@@ -119,6 +142,12 @@ var (
 	githubScanPRComments        = githubScan.Flag("pr-comments", "Include pull request descriptions and comments in scan.").Bool()
 	githubScanGistComments      = githubScan.Flag("gist-comments", "Include gist comments in scan.").Bool()
 	githubCommentsTimeframeDays = githubScan.Flag("comments-timeframe", "Number of days in the past to review when scanning issue, PR, and gist comments.").Uint32()
+	githubScanActionsLogs       = githubScan.Flag("actions-logs", "Include GitHub Actions workflow run logs in scan.").Bool()
+	githubScanActionsArtifacts  = githubScan.Flag("actions-artifacts", "Include GitHub Actions workflow run artifacts in scan.").Bool()
+	githubActionsTimeframeDays  = githubScan.Flag("actions-timeframe", "Number of days in the past to review when scanning workflow run logs and artifacts.").Uint32()
+	githubMaxActionsDownload    = githubScan.Flag("max-actions-download-size", "Maximum size of workflow run log archives and artifacts to scan. Larger ones will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
+	githubScanReleases          = githubScan.Flag("include-releases", "Include release notes and release assets in scan.").Bool()
+	githubMaxReleaseAssetSize   = githubScan.Flag("max-release-asset-size", "Maximum size of release assets to scan. Assets larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
 	githubAuthInUrl             = githubScan.Flag("auth-in-url", "Embed authentication credentials in repository URLs instead of using secure HTTP headers").Bool()
 
 	// GitHub Cross Fork Object Reference Experimental Feature
@@ -133,15 +162,65 @@ var (
 
 	gitlabScan = cli.Command("gitlab", "Find credentials in GitLab repositories.")
 	// TODO: Add more GitLab options
-	gitlabScanEndpoint     = gitlabScan.Flag("endpoint", "GitLab endpoint.").Default("https://gitlab.com").String()
-	gitlabScanRepos        = gitlabScan.Flag("repo", "GitLab repo url. You can repeat this flag. Leave empty to scan all repos accessible with provided credential. Example: https://gitlab.com/org/repo.git").Strings()
-	gitlabScanToken        = gitlabScan.Flag("token", "GitLab token. Can be provided with environment variable GITLAB_TOKEN.").Envar("GITLAB_TOKEN").Required().String()
-	gitlabScanGroupIds     = gitlabScan.Flag("group-id", "GitLab group ID. If provided, it will scan the group and its subgroups. You can repeat this flag.").Strings()
-	gitlabScanIncludePaths = gitlabScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
-	gitlabScanExcludePaths = gitlabScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
-	gitlabScanIncludeRepos = gitlabScan.Flag("include-repos", `Repositories to include in an org scan. This can also be a glob pattern. You can repeat this flag. Must use Gitlab repo full name. Example: "trufflesecurity/trufflehog", "trufflesecurity/t*"`).Strings()
-	gitlabScanExcludeRepos = gitlabScan.Flag("exclude-repos", `Repositories to exclude in an org scan. This can also be a glob pattern. You can repeat this flag. Must use Gitlab repo full name. Example: "trufflesecurity/driftwood", "trufflesecurity/d*"`).Strings()
-	gitlabAuthInUrl        = gitlabScan.Flag("auth-in-url", "Embed authentication credentials in repository URLs instead of using secure HTTP headers").Bool()
+	gitlabScanEndpoint               = gitlabScan.Flag("endpoint", "GitLab endpoint.").Default("https://gitlab.com").String()
+	gitlabScanRepos                  = gitlabScan.Flag("repo", "GitLab repo url. You can repeat this flag. Leave empty to scan all repos accessible with provided credential. Example: https://gitlab.com/org/repo.git").Strings()
+	gitlabScanToken                  = gitlabScan.Flag("token", "GitLab token. Can be provided with environment variable GITLAB_TOKEN.").Envar("GITLAB_TOKEN").Required().String()
+	gitlabScanGroupIds               = gitlabScan.Flag("group-id", "GitLab group ID. If provided, it will scan the group and its subgroups. You can repeat this flag.").Strings()
+	gitlabScanIncludePaths           = gitlabScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	gitlabScanExcludePaths           = gitlabScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	gitlabScanIncludeRepos           = gitlabScan.Flag("include-repos", `Repositories to include in an org scan. This can also be a glob pattern. You can repeat this flag. Must use Gitlab repo full name. Example: "trufflesecurity/trufflehog", "trufflesecurity/t*"`).Strings()
+	gitlabScanExcludeRepos           = gitlabScan.Flag("exclude-repos", `Repositories to exclude in an org scan. This can also be a glob pattern. You can repeat this flag. Must use Gitlab repo full name. Example: "trufflesecurity/driftwood", "trufflesecurity/d*"`).Strings()
+	gitlabAuthInUrl                  = gitlabScan.Flag("auth-in-url", "Embed authentication credentials in repository URLs instead of using secure HTTP headers").Bool()
+	gitlabScanIssueComments          = gitlabScan.Flag("issue-comments", "Include issue descriptions and comments in scan.").Bool()
+	gitlabScanMRComments             = gitlabScan.Flag("mr-comments", "Include merge request descriptions and discussions in scan.").Bool()
+	gitlabScanIncludeSnippets        = gitlabScan.Flag("include-snippets", "Include personal and project snippets in scan.").Bool()
+	gitlabScanIncludeWikis           = gitlabScan.Flag("include-wikis", "Include project wikis in scan.").Bool()
+	gitlabScanCommentsTimeframeDays  = gitlabScan.Flag("comments-timeframe", "Number of days in the past to review when scanning issue and merge request comments.").Uint32()
+	gitlabScanPipelineLogs           = gitlabScan.Flag("pipeline-logs", "Include CI/CD job logs in scan.").Bool()
+	gitlabScanPipelineArtifacts      = gitlabScan.Flag("pipeline-artifacts", "Include CI/CD job artifacts in scan.").Bool()
+	gitlabScanPipelinesTimeframeDays = gitlabScan.Flag("pipelines-timeframe", "Number of days in the past to review when scanning CI/CD pipelines.").Uint32()
+	gitlabMaxPipelineDownload        = gitlabScan.Flag("max-pipeline-download-size", "Maximum size of CI/CD job logs and artifacts archives to scan. Larger ones will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
+
+	bitbucketScan                 = cli.Command("bitbucket", "Find credentials in Bitbucket Cloud or Data Center repositories.")
+	bitbucketScanEndpoint         = bitbucketScan.Flag("endpoint", "Bitbucket endpoint. Leave empty for bitbucket.org. Example: https://bitbucket.example.com").String()
+	bitbucketScanToken            = bitbucketScan.Flag("token", "Bitbucket access token. Can be provided with environment variable BITBUCKET_TOKEN.").Envar("BITBUCKET_TOKEN").String()
+	bitbucketScanUsername         = bitbucketScan.Flag("username", "Bitbucket username, used with --password. Can be provided with environment variable BITBUCKET_USERNAME.").Envar("BITBUCKET_USERNAME").String()
+	bitbucketScanPassword         = bitbucketScan.Flag("password", "Bitbucket password or app password. Can be provided with environment variable BITBUCKET_PASSWORD.").Envar("BITBUCKET_PASSWORD").String()
+	bitbucketScanRepos            = bitbucketScan.Flag("repo", "Bitbucket repository clone URL. You can repeat this flag. Leave empty to scan all repositories accessible with the provided credential.").Strings()
+	bitbucketScanIgnoreRepos      = bitbucketScan.Flag("ignore-repos", `Repositories to exclude from scan. This can also be a glob pattern. You can repeat this flag. Must use the workspace (Cloud) or project key (Data Center) and repo slug. Example: "workspace/repo", "PROJ/*"`).Strings()
+	bitbucketScanInstallationType = bitbucketScan.Flag("installation-type", "Bitbucket installation type: cloud or data-center. Detected from the endpoint if not provided.").Enum("cloud", "data-center")
+	bitbucketScanIncludePaths     = bitbucketScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
+	bitbucketScanExcludePaths     = bitbucketScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+
+	confluenceScan                  = cli.Command("confluence", "Find credentials in Confluence pages, page history and attachments.")
+	confluenceEndpoint              = confluenceScan.Flag("endpoint", "Confluence base URL. Example: https://example.atlassian.net/wiki").Envar("CONFLUENCE_ENDPOINT").Required().String()
+	confluenceUsername              = confluenceScan.Flag("username", "Confluence username or email, used with --password. Can be provided with environment variable CONFLUENCE_USERNAME.").Envar("CONFLUENCE_USERNAME").String()
+	confluencePassword              = confluenceScan.Flag("password", "Confluence password or API token. Can be provided with environment variable CONFLUENCE_PASSWORD.").Envar("CONFLUENCE_PASSWORD").String()
+	confluenceToken                 = confluenceScan.Flag("token", "Confluence personal access token. Can be provided with environment variable CONFLUENCE_TOKEN.").Envar("CONFLUENCE_TOKEN").String()
+	confluenceSpaces                = confluenceScan.Flag("space", "Confluence space key to scan. You can repeat this flag. Leave empty to scan all accessible spaces.").Strings()
+	confluenceIgnoreSpaces          = confluenceScan.Flag("ignore-space", "Confluence space key to exclude from scan. You can repeat this flag.").Strings()
+	confluenceSpacesScope           = confluenceScan.Flag("spaces-scope", "Which spaces to list when no --space is given: all, global or personal.").Default("all").Enum("all", "global", "personal")
+	confluenceIncludeAttachments    = confluenceScan.Flag("include-attachments", "Scan page attachments.").Bool()
+	confluenceSkipHistory           = confluenceScan.Flag("skip-history", "Only scan the current version of each page.").Bool()
+	confluenceInsecureSkipVerifyTLS = confluenceScan.Flag("insecure-skip-verify-tls", "Skip TLS verification").Envar("CONFLUENCE_INSECURE_SKIP_VERIFY_TLS").Bool()
+
+	jiraScan                  = cli.Command("jira", "Find credentials in Jira issues, comments and attachments.")
+	jiraEndpoint              = jiraScan.Flag("endpoint", "Jira base URL. Example: https://example.atlassian.net").Envar("JIRA_ENDPOINT").Required().String()
+	jiraUsername              = jiraScan.Flag("username", "Jira username or email, used with --password. Can be provided with environment variable JIRA_USERNAME.").Envar("JIRA_USERNAME").String()
+	jiraPassword              = jiraScan.Flag("password", "Jira password or API token. Can be provided with environment variable JIRA_PASSWORD.").Envar("JIRA_PASSWORD").String()
+	jiraToken                 = jiraScan.Flag("token", "Jira personal access token. Can be provided with environment variable JIRA_TOKEN.").Envar("JIRA_TOKEN").String()
+	jiraProjects              = jiraScan.Flag("project", "Jira project key to scan. You can repeat this flag. Leave empty to scan all accessible projects.").Strings()
+	jiraIgnoreProjects        = jiraScan.Flag("ignore-project", "Jira project key to exclude from scan. You can repeat this flag.").Strings()
+	jiraJQL                   = jiraScan.Flag("jql", `JQL query to restrict the scanned issues. Must not contain ORDER BY. Example: "labels = ops"`).String()
+	jiraSince                 = jiraScan.Flag("since", "Only scan issues updated since this time. Accepts a date (2006-01-02), an RFC 3339 timestamp, or a duration relative to now (e.g. 720h).").String()
+	jiraInsecureSkipVerifyTLS = jiraScan.Flag("insecure-skip-verify-tls", "Skip TLS verification").Envar("JIRA_INSECURE_SKIP_VERIFY_TLS").Bool()
+
+	slackScan           = cli.Command("slack", "Find credentials in Slack messages and files, from a workspace export or the API.")
+	slackToken          = slackScan.Flag("token", "Slack bot or user token for API mode. Can be provided with environment variable SLACK_TOKEN.").Envar("SLACK_TOKEN").String()
+	slackExport         = slackScan.Flag("export", "Path to a Slack workspace export zip or extracted directory. Scans the export instead of calling the API.").String()
+	slackChannels       = slackScan.Flag("channel", "Channel name or ID to scan. You can repeat this flag. Leave empty to scan all channels.").Strings()
+	slackIgnoreChannels = slackScan.Flag("ignore-channel", "Channel name or ID to exclude from scan. You can repeat this flag.").Strings()
+	slackWorkspaceURL   = slackScan.Flag("workspace-url", "Workspace URL used to link to messages, e.g. https://acme.slack.com. Looked up automatically in API mode.").String()
 
 	filesystemScan  = cli.Command("filesystem", "Find credentials in a filesystem.")
 	filesystemPaths = filesystemScan.Arg("path", "Path to file or directory to scan.").Strings()
@@ -151,6 +230,9 @@ var (
 	// filesystemScanRecursive = filesystemScan.Flag("recursive", "Scan recursively.").Short('r').Bool()
 	filesystemScanIncludePaths = filesystemScan.Flag("include-paths", "Path to file with newline separated regexes for files to include in scan.").Short('i').String()
 	filesystemScanExcludePaths = filesystemScan.Flag("exclude-paths", "Path to file with newline separated regexes for files to exclude in scan.").Short('x').String()
+	filesystemUseIgnoreFiles   = filesystemScan.Flag("use-ignore-files", "Skip paths ignored by .gitignore and .trufflehogignore files in the scanned directories.").Bool()
+	filesystemFollowSymlinks   = filesystemScan.Flag("follow-symlinks", "Follow symlinks to files and directories. Each directory is scanned once, so symlink loops are skipped.").Bool()
+	filesystemConfineSymlinks  = filesystemScan.Flag("confine-symlinks", "Follow symlinks only if their target is inside the scanned path. Implies --follow-symlinks.").Bool()
 
 	s3Scan              = cli.Command("s3", "Find credentials in S3 buckets.")
 	s3ScanKey           = s3Scan.Flag("key", "S3 key used to authenticate. Can be provided with environment variable AWS_ACCESS_KEY_ID.").Envar("AWS_ACCESS_KEY_ID").String()
@@ -234,6 +316,10 @@ var (
 	jenkinsUsername              = jenkinsScan.Flag("username", "Jenkins username").Envar("JENKINS_USERNAME").String()
 	jenkinsPassword              = jenkinsScan.Flag("password", "Jenkins password").Envar("JENKINS_PASSWORD").String()
 	jenkinsInsecureSkipVerifyTLS = jenkinsScan.Flag("insecure-skip-verify-tls", "Skip TLS verification").Envar("JENKINS_INSECURE_SKIP_VERIFY_TLS").Bool()
+	jenkinsJobConfigs            = jenkinsScan.Flag("job-configs", "Include the config.xml of each job in scan.").Bool()
+	jenkinsBuildDetails          = jenkinsScan.Flag("build-details", "Include build parameters and injected environment variables in scan.").Bool()
+	jenkinsBuildArtifacts        = jenkinsScan.Flag("build-artifacts", "Include archived build artifacts in scan.").Bool()
+	jenkinsMaxArtifactSize       = jenkinsScan.Flag("max-artifact-size", "Maximum size of build artifacts to scan. Artifacts larger than this will be skipped. (Byte units eg. 512B, 2KB, 4MB)").Default("250MB").Bytes()
 
 	huggingfaceScan     = cli.Command("huggingface", "Find credentials in HuggingFace datasets, models and spaces.")
 	huggingfaceEndpoint = huggingfaceScan.Flag("endpoint", "HuggingFace endpoint.").Default("https://huggingface.co").String()
@@ -259,8 +345,25 @@ var (
 	stdinInputScan = cli.Command("stdin", "Find credentials from stdin.")
 	multiScanScan  = cli.Command("multi-scan", "Find credentials in multiple sources defined in configuration.")
 
-	analyzeCmd = analyzer.Command(cli)
-	usingTUI   = false
+	hookCmd            = cli.Command("hook", "Run TruffleHog as a git pre-commit, pre-push or pre-receive hook.")
+	hookInstall        = hookCmd.Command("install", "Install TruffleHog as a hook in a git repository.")
+	hookInstallType    = hookInstall.Arg("type", "Hook type: pre-commit, pre-push or pre-receive.").Required().Enum(hook.Types...)
+	hookInstallRepo    = hookInstall.Flag("repo", "Path to the git repository.").Default(".").String()
+	hookInstallCommand = hookInstall.Flag("command", "Command the hook uses to invoke TruffleHog.").Default("trufflehog").String()
+	hookInstallForce   = hookInstall.Flag("force", "Replace an existing hook that wasn't installed by TruffleHog.").Bool()
+	hookRun            = hookCmd.Command("run", "Scan the changes of a commit or push. Installed hooks run this; exits with code 183 if secrets are found.")
+	hookRunType        = hookRun.Arg("type", "Hook type: pre-commit, pre-push or pre-receive.").Required().Enum(hook.Types...)
+	_                  = hookRun.Arg("args", "Arguments git passes to the hook. Ignored.").Strings()
+	hookRunRepo        = hookRun.Flag("repo", "Path to the git repository.").Default(".").String()
+
+	detectorsCmd     = cli.Command("detectors", "Work with the custom detectors defined in the configuration file.")
+	detectorsTestCmd = detectorsCmd.Command("test", "Run the custom detectors in --config against their should_match and should_not_match samples. Exits with code 1 if any sample fails.")
+
+	analyzeCmd   = analyzer.Command(cli)
+	analyzeType  = analyzeCmd.Arg("type", "Type of credential to analyze, e.g. github. Omit to pick one interactively.").String()
+	analyzeKey   = analyzeCmd.Flag("key", "Credential to analyze. Can be provided with environment variable TRUFFLEHOG_ANALYZE_KEY.").Envar("TRUFFLEHOG_ANALYZE_KEY").String()
+	analyzeParts = analyzeCmd.Flag("part", "Additional credential part as name=value, e.g. sid=... for twilio or url=... for shopify. Can be repeated.").StringMap()
+	usingTUI     = false
 )
 
 func init() {
@@ -285,7 +388,10 @@ func init() {
 		usingTUI = ok
 	}
 
-	if isatty.IsTerminal(os.Stdout.Fd()) && (len(os.Args) <= 1 || os.Args[1] == analyzeCmd.FullCommand()) {
+	// The analyze command only opens the TUI when it is run without any
+	// arguments, since the credential may also come from the environment.
+	interactiveAnalyze := len(os.Args) == 2 && os.Args[1] == analyzeCmd.FullCommand()
+	if isatty.IsTerminal(os.Stdout.Fd()) && (len(os.Args) <= 1 || interactiveAnalyze) {
 		args := tui.Run(os.Args[1:])
 		if len(args) == 0 {
 			os.Exit(0)
@@ -375,6 +481,10 @@ func main() {
 	}
 }
 
+// activeCheckpointer is the checkpointer of the running scan, if any, so
+// progress can be saved one last time when the process is signaled to stop.
+var activeCheckpointer atomic.Pointer[sources.Checkpointer]
+
 func run(state overseer.State) {
 
 	ctx, cancel := context.WithCancelCause(context.Background())
@@ -396,6 +506,10 @@ func run(state overseer.State) {
 		logger.Info("Received signal, shutting down.")
 		cancel(fmt.Errorf("canceling context due to signal"))
 
+		if err := activeCheckpointer.Load().Save(); err != nil {
+			logger.Error(err, "error saving checkpoint")
+		}
+
 		if err := cleantemp.CleanTempArtifacts(ctx); err != nil {
 			logger.Error(err, "error cleaning temporary artifacts")
 		} else {
@@ -458,6 +572,36 @@ func run(state overseer.State) {
 	// OSS Default simplified gitlab enumeration
 	feature.UseSimplifiedGitlabEnumeration.Store(true)
 
+	if cmd == hookInstall.FullCommand() {
+		hookPath, err := hook.Install(ctx, *hookInstallRepo, hook.Type(*hookInstallType), hook.InstallOptions{
+			Command: *hookInstallCommand,
+			Force:   *hookInstallForce,
+		})
+		if err != nil {
+			logFatal(err, "error installing hook")
+		}
+		logger.Info("installed hook", "type", *hookInstallType, "path", hookPath)
+		return
+	}
+
+	if cmd == analyzeCmd.FullCommand() {
+		if err := runAnalyze(ctx, os.Stdout); err != nil {
+			logFatal(err, "error analyzing credential")
+		}
+		return
+	}
+
+	if cmd == detectorsTestCmd.FullCommand() {
+		passed, err := runDetectorsTest(ctx)
+		if err != nil {
+			logFatal(err, "error testing custom detectors")
+		}
+		if !passed {
+			os.Exit(1)
+		}
+		return
+	}
+
 	conf := &config.Config{}
 	if *configFilename != "" {
 		var err error
@@ -491,11 +635,15 @@ func run(state overseer.State) {
 		printer = new(output.JSONPrinter)
 	case *gitHubActionsFormat:
 		printer = new(output.GitHubActionsPrinter)
+	case *sarifOut:
+		printer = new(output.SARIFPrinter)
+	case cmd == hookRun.FullCommand():
+		printer = new(output.HookPrinter)
 	default:
 		printer = new(output.PlainPrinter)
 	}
 
-	if !*jsonLegacy && !*jsonOut {
+	if !*jsonLegacy && !*jsonOut && !*sarifOut && cmd != hookRun.FullCommand() {
 		fmt.Fprintf(os.Stderr, "🐷🔑🐷  TruffleHog. Unearth your secrets. 🐷🔑🐷\n\n")
 	}
 
@@ -531,13 +679,35 @@ func run(state overseer.State) {
 		Results:                  parsedResults,
 		PrintAvgDetectorTime:     *printAvgDetectorTime,
 		ShouldScanEntireChunk:    *scanEntireChunk,
+		MaxDecodeDepth:           *maxDecodeDepth,
 		VerificationCacheMetrics: &verificationCacheMetrics,
 	}
 
+	if *analyzeVerified {
+		engConf.AnalyzeResult = analyzer.AnalyzeResult
+		engConf.AnalyzeTimeout = *analyzeTimeout
+	}
+
 	if !*noVerificationCache {
 		engConf.VerificationResultCache = simple.NewCache[detectors.Result]()
 	}
 
+	var baselineRecorder *baseline.Baseline
+	switch {
+	case *writeBaseline && *baselineFile == "":
+		logFatal(fmt.Errorf("missing required flag: --baseline"), "--write-baseline requires a baseline file path")
+	case *writeBaseline:
+		baselineRecorder = baseline.New()
+		engConf.BaselineRecorder = baselineRecorder
+	case *baselineFile != "":
+		known, err := baseline.Load(*baselineFile)
+		if err != nil {
+			logFatal(err, "error loading baseline file")
+		}
+		logger.V(2).Info("loaded baseline", "path", *baselineFile, "findings", known.Len())
+		engConf.Baseline = known
+	}
+
 	// Check that there are no sources defined for non-scan subcommands. If
 	// there are, return an error as it is ambiguous what the user is
 	// trying to do.
@@ -560,6 +730,21 @@ func run(state overseer.State) {
 		logFatal(err, "error running scan")
 	}
 
+	if baselineRecorder != nil {
+		if err := baselineRecorder.WriteFile(*baselineFile); err != nil {
+			logFatal(err, "error writing baseline file")
+		}
+		logger.Info("baseline written", "path", *baselineFile, "findings", baselineRecorder.Len())
+	}
+
+	// The SARIF printer buffers results and writes a single document once
+	// the scan has finished.
+	if sarifPrinter, ok := printer.(*output.SARIFPrinter); ok {
+		if err := sarifPrinter.Close(); err != nil {
+			logFatal(err, "error writing SARIF output")
+		}
+	}
+
 	verificationCacheMetricsSnapshot := struct {
 		Hits                    int32
 		Misses                  int32
@@ -585,6 +770,13 @@ func run(state overseer.State) {
 		"verification_caching", verificationCacheMetricsSnapshot,
 	)
 
+	if cmd == hookRun.FullCommand() && metrics.hasFoundResults {
+		if hookPrinter, ok := printer.(*output.HookPrinter); ok {
+			fmt.Fprintln(os.Stderr, hook.Summary(hook.Type(*hookRunType), hookPrinter.Found()))
+		}
+		os.Exit(183)
+	}
+
 	if metrics.hasFoundResults && *fail {
 		logger.V(2).Info("exiting with code 183 because results were found")
 		os.Exit(183)
@@ -689,6 +881,18 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		sources.WithBufferedOutput(defaultOutputBufferSize),
 	}
 
+	if *checkpointFile != "" {
+		checkpointer, err := sources.NewCheckpointer(*checkpointFile)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("error loading checkpoint: %w", err)
+		}
+		if n := checkpointer.Resumable(); n > 0 {
+			ctx.Logger().Info("resuming from checkpoint", "path", *checkpointFile, "jobs", n)
+		}
+		opts = append(opts, sources.WithCheckpointer(checkpointer, *checkpointInterval))
+		activeCheckpointer.Store(checkpointer)
+	}
+
 	if jobReportWriter != nil {
 		unitHook, finishedMetrics := sources.NewUnitHook(ctx)
 		opts = append(opts, sources.WithReportHook(unitHook))
@@ -729,6 +933,29 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			MaxDepth:         *gitScanMaxDepth,
 			Bare:             *gitScanBare,
 			ExcludeGlobs:     *gitScanExcludeGlobs,
+			IncludeTags:      *gitScanIncludeTags,
+			IncludeStashes:   *gitScanIncludeStashes,
+			IncludeReflog:    *gitScanIncludeReflog,
+			IncludeDangling:  *gitScanIncludeDangling,
+		}
+		if *gitScanRangesFile != "" {
+			if *gitScanBranch != "" || *gitScanSinceCommit != "" {
+				return scanMetrics, fmt.Errorf("invalid config: --ranges-file cannot be combined with --branch or --since-commit")
+			}
+			ranges, err := readGitRanges(*gitScanRangesFile)
+			if err != nil {
+				return scanMetrics, fmt.Errorf("could not read commit ranges: %v", err)
+			}
+			gitCfg.Ranges = ranges
+		}
+		if *gitScanStateFile != "" {
+			if *gitScanSinceCommit != "" || *gitScanRangesFile != "" {
+				return scanMetrics, fmt.Errorf("invalid config: --state-file cannot be combined with --since-commit or --ranges-file")
+			}
+			if *gitScanBranch == "" {
+				return scanMetrics, fmt.Errorf("invalid config: --state-file requires --branch")
+			}
+			gitCfg.StateFile = *gitScanStateFile
 		}
 		if ref, err := eng.ScanGit(ctx, gitCfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan Git: %v", err)
@@ -762,6 +989,12 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			IncludePullRequestComments: *githubScanPRComments,
 			IncludeGistComments:        *githubScanGistComments,
 			CommentsTimeframeDays:      *githubCommentsTimeframeDays,
+			IncludeActionsLogs:         *githubScanActionsLogs,
+			IncludeActionsArtifacts:    *githubScanActionsArtifacts,
+			ActionsTimeframeDays:       *githubActionsTimeframeDays,
+			MaxActionsDownloadSize:     int64(*githubMaxActionsDownload),
+			IncludeReleases:            *githubScanReleases,
+			MaxReleaseAssetSize:        int64(*githubMaxReleaseAssetSize),
 			Filter:                     filter,
 			AuthInUrl:                  *githubAuthInUrl,
 		}
@@ -794,20 +1027,101 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		}
 
 		cfg := sources.GitlabConfig{
-			Endpoint:     *gitlabScanEndpoint,
-			Token:        *gitlabScanToken,
-			Repos:        *gitlabScanRepos,
-			GroupIds:     *gitlabScanGroupIds,
-			IncludeRepos: *gitlabScanIncludeRepos,
-			ExcludeRepos: *gitlabScanExcludeRepos,
-			Filter:       filter,
-			AuthInUrl:    *gitlabAuthInUrl,
+			Endpoint:                    *gitlabScanEndpoint,
+			Token:                       *gitlabScanToken,
+			Repos:                       *gitlabScanRepos,
+			GroupIds:                    *gitlabScanGroupIds,
+			IncludeRepos:                *gitlabScanIncludeRepos,
+			ExcludeRepos:                *gitlabScanExcludeRepos,
+			Filter:                      filter,
+			AuthInUrl:                   *gitlabAuthInUrl,
+			IncludeIssueComments:        *gitlabScanIssueComments,
+			IncludeMergeRequestComments: *gitlabScanMRComments,
+			IncludeSnippets:             *gitlabScanIncludeSnippets,
+			IncludeWikis:                *gitlabScanIncludeWikis,
+			CommentsTimeframeDays:       *gitlabScanCommentsTimeframeDays,
+			IncludePipelineLogs:         *gitlabScanPipelineLogs,
+			IncludePipelineArtifacts:    *gitlabScanPipelineArtifacts,
+			PipelinesTimeframeDays:      *gitlabScanPipelinesTimeframeDays,
+			MaxPipelineDownloadSize:     int64(*gitlabMaxPipelineDownload),
 		}
 		if ref, err := eng.ScanGitLab(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan GitLab: %v", err)
 		} else {
 			refs = []sources.JobProgressRef{ref}
 		}
+	case bitbucketScan.FullCommand():
+		filter, err := common.FilterFromFiles(*bitbucketScanIncludePaths, *bitbucketScanExcludePaths)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("could not create filter: %v", err)
+		}
+
+		cfg := sources.BitbucketConfig{
+			Endpoint:         *bitbucketScanEndpoint,
+			Token:            *bitbucketScanToken,
+			Username:         *bitbucketScanUsername,
+			Password:         *bitbucketScanPassword,
+			Repos:            *bitbucketScanRepos,
+			IgnoreRepos:      *bitbucketScanIgnoreRepos,
+			InstallationType: *bitbucketScanInstallationType,
+			Filter:           filter,
+		}
+		if ref, err := eng.ScanBitbucket(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Bitbucket: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case confluenceScan.FullCommand():
+		cfg := sources.ConfluenceConfig{
+			Endpoint:              *confluenceEndpoint,
+			Username:              *confluenceUsername,
+			Password:              *confluencePassword,
+			Token:                 *confluenceToken,
+			Spaces:                *confluenceSpaces,
+			IgnoreSpaces:          *confluenceIgnoreSpaces,
+			SpacesScope:           *confluenceSpacesScope,
+			IncludeAttachments:    *confluenceIncludeAttachments,
+			SkipHistory:           *confluenceSkipHistory,
+			InsecureSkipVerifyTLS: *confluenceInsecureSkipVerifyTLS,
+		}
+		if ref, err := eng.ScanConfluence(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Confluence: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case jiraScan.FullCommand():
+		cfg := sources.JiraConfig{
+			Endpoint:              *jiraEndpoint,
+			Username:              *jiraUsername,
+			Password:              *jiraPassword,
+			Token:                 *jiraToken,
+			Projects:              *jiraProjects,
+			IgnoreProjects:        *jiraIgnoreProjects,
+			JQL:                   *jiraJQL,
+			Since:                 *jiraSince,
+			InsecureSkipVerifyTLS: *jiraInsecureSkipVerifyTLS,
+		}
+		if ref, err := eng.ScanJira(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Jira: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
+	case slackScan.FullCommand():
+		if *slackToken == "" && *slackExport == "" {
+			return scanMetrics, fmt.Errorf("invalid config: you must specify either --token or --export")
+		}
+		cfg := sources.SlackConfig{
+			Token:          *slackToken,
+			Channels:       *slackChannels,
+			IgnoreChannels: *slackIgnoreChannels,
+			ExportPath:     *slackExport,
+			WorkspaceURL:   *slackWorkspaceURL,
+		}
+		if ref, err := eng.ScanSlack(ctx, cfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Slack: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
 	case filesystemScan.FullCommand():
 		if len(*filesystemDirectories) > 0 {
 			ctx.Logger().Info("--directory flag is deprecated, please pass directories as arguments")
@@ -819,6 +1133,9 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			Paths:            paths,
 			IncludePathsFile: *filesystemScanIncludePaths,
 			ExcludePathsFile: *filesystemScanExcludePaths,
+			UseIgnoreFiles:   *filesystemUseIgnoreFiles,
+			FollowSymlinks:   *filesystemFollowSymlinks,
+			ConfineSymlinks:  *filesystemConfineSymlinks,
 		}
 		if ref, err := eng.ScanFileSystem(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan filesystem: %v", err)
@@ -961,6 +1278,10 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 			InsecureSkipVerifyTLS: *jenkinsInsecureSkipVerifyTLS,
 			Username:              *jenkinsUsername,
 			Password:              *jenkinsPassword,
+			IncludeJobConfigs:     *jenkinsJobConfigs,
+			IncludeBuildDetails:   *jenkinsBuildDetails,
+			IncludeBuildArtifacts: *jenkinsBuildArtifacts,
+			MaxArtifactSize:       int64(*jenkinsMaxArtifactSize),
 		}
 		if ref, err := eng.ScanJenkins(ctx, cfg); err != nil {
 			return scanMetrics, fmt.Errorf("failed to scan Jenkins: %v", err)
@@ -1011,6 +1332,26 @@ func runSingleScan(ctx context.Context, cmd string, cfg engine.Config) (metrics,
 		} else {
 			refs = rs
 		}
+	case hookRun.FullCommand():
+		target, err := hook.NewTarget(ctx, hook.Type(*hookRunType), *hookRunRepo, os.Stdin)
+		if err != nil {
+			return scanMetrics, fmt.Errorf("failed to read hook input: %v", err)
+		}
+		if target.Empty() {
+			ctx.Logger().V(2).Info("nothing to scan", "hook", *hookRunType)
+			break
+		}
+		gitCfg := sources.GitConfig{
+			URI:        "file://" + target.Path,
+			Bare:       target.Bare,
+			Revisions:  target.Revisions,
+			StagedOnly: target.Staged,
+		}
+		if ref, err := eng.ScanGit(ctx, gitCfg); err != nil {
+			return scanMetrics, fmt.Errorf("failed to scan Git: %v", err)
+		} else {
+			refs = []sources.JobProgressRef{ref}
+		}
 	case stdinInputScan.FullCommand():
 		cfg := sources.StdinConfig{}
 		if ref, err := eng.ScanStdinInput(ctx, cfg); err != nil {
@@ -1110,6 +1451,37 @@ func printAverageDetectorTime(e *engine.Engine) {
 	}
 }
 
+// readGitRanges reads newline separated commit ranges from path, or from stdin
+// if path is "-". Blank lines and lines starting with # are ignored.
+func readGitRanges(path string) ([]string, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var ranges []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ranges = append(ranges, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("no commit ranges found in %s", path)
+	}
+	return ranges, nil
+}
+
 // Function to check if the commit is valid
 func isValidCommit(uri, commit string) bool {
 	// handle file:// urls
@@ -1121,3 +1493,82 @@ func isValidCommit(uri, commit string) bool {
 
 	return strings.TrimSpace(string(output)) == "commit"
 }
+
+// runAnalyze analyzes the credential given on the command line. With --json,
+// the analyzer's resources, permissions and bindings are written to w as a
+// single JSON object instead of printing tables.
+func runAnalyze(ctx context.Context, w io.Writer) error {
+	if *analyzeType == "" {
+		return fmt.Errorf("an analyzer type is required, one of: %s", strings.ToLower(strings.Join(analyzers.AvailableAnalyzers(), ", ")))
+	}
+	parts := make(map[string]string, len(*analyzeParts)+1)
+	maps.Copy(parts, *analyzeParts)
+	if *analyzeKey != "" {
+		parts["key"] = *analyzeKey
+	}
+	secretInfo := analyzer.SecretInfo{Parts: parts, Cfg: &analyzerconfig.Config{}}
+
+	if !*jsonOut {
+		analyzer.Run(*analyzeType, secretInfo)
+		return nil
+	}
+
+	result, err := analyzer.Analyze(ctx, *analyzeType, secretInfo)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(w).Encode(result)
+}
+
+// runDetectorsTest runs the custom detectors in the configuration file against
+// their should_match and should_not_match samples and reports whether all of
+// them passed. With --json, each result is written to stdout as a JSON object.
+func runDetectorsTest(ctx context.Context) (bool, error) {
+	if *configFilename == "" {
+		return false, fmt.Errorf("a configuration file with custom detectors is required, set it with --config")
+	}
+	conf, err := config.Read(*configFilename)
+	if err != nil {
+		return false, err
+	}
+
+	passed := true
+	encoder := json.NewEncoder(os.Stdout)
+	for _, d := range conf.Detectors {
+		detector, ok := d.(*custom_detectors.CustomRegexWebhook)
+		if !ok {
+			continue
+		}
+		results, err := detector.RunTestCases(ctx)
+		if err != nil {
+			return false, fmt.Errorf("detector %q: %w", detector.GetName(), err)
+		}
+		if len(results) == 0 && !*jsonOut {
+			fmt.Printf("SKIP %s: no should_match or should_not_match samples\n", detector.GetName())
+		}
+		for _, result := range results {
+			passed = passed && result.Passed()
+			if *jsonOut {
+				if err := encoder.Encode(result); err != nil {
+					return false, err
+				}
+				continue
+			}
+
+			status, expected := "PASS", "should_not_match"
+			if !result.Passed() {
+				status = "FAIL"
+			}
+			if result.ShouldMatch {
+				expected = "should_match"
+			}
+			fmt.Printf("%s %s: %s %q\n", status, result.Detector, expected, result.Sample)
+			if !result.Passed() {
+				for _, rule := range result.Rules {
+					fmt.Printf("    %s\n", rule)
+				}
+			}
+		}
+	}
+	return passed, nil
+}
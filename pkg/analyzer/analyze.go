@@ -0,0 +1,258 @@
+package analyzer
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/airbrake"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/airtable/airtableoauth"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/airtable/airtablepat"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/anthropic"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/asana"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/bitbucket"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/databricks"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/datadog"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/digitalocean"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/dockerhub"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/dropbox"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/elevenlabs"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/fastly"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/figma"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/github"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/gitlab"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/groq"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/huggingface"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/jira"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/launchdarkly"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/mailchimp"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/mailgun"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/monday"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/mux"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/mysql"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/netlify"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/ngrok"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/notion"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/openai"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/opsgenie"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/plaid"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/planetscale"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/postgres"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/posthog"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/postman"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/privatekey"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/sendgrid"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/shopify"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/slack"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/sourcegraph"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/square"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/stripe"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/analyzers/twilio"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/analyzer/config"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+)
+
+// analyzerFactories builds the analyzer for each key type accepted by Run.
+var analyzerFactories = map[string]func(cfg *config.Config) analyzers.Analyzer{
+	"airbrake":      func(cfg *config.Config) analyzers.Analyzer { return airbrake.Analyzer{Cfg: cfg} },
+	"airtableoauth": func(cfg *config.Config) analyzers.Analyzer { return airtableoauth.Analyzer{Cfg: cfg} },
+	"airtablepat":   func(cfg *config.Config) analyzers.Analyzer { return airtablepat.Analyzer{Cfg: cfg} },
+	"anthropic":     func(cfg *config.Config) analyzers.Analyzer { return anthropic.Analyzer{Cfg: cfg} },
+	"asana":         func(cfg *config.Config) analyzers.Analyzer { return asana.Analyzer{Cfg: cfg} },
+	"bitbucket":     func(cfg *config.Config) analyzers.Analyzer { return bitbucket.Analyzer{Cfg: cfg} },
+	"databricks":    func(cfg *config.Config) analyzers.Analyzer { return databricks.Analyzer{Cfg: cfg} },
+	"datadog":       func(cfg *config.Config) analyzers.Analyzer { return datadog.Analyzer{Cfg: cfg} },
+	"digitalocean":  func(cfg *config.Config) analyzers.Analyzer { return digitalocean.Analyzer{Cfg: cfg} },
+	"dockerhub":     func(cfg *config.Config) analyzers.Analyzer { return dockerhub.Analyzer{Cfg: cfg} },
+	"dropbox":       func(cfg *config.Config) analyzers.Analyzer { return dropbox.Analyzer{Cfg: cfg} },
+	"elevenlabs":    func(cfg *config.Config) analyzers.Analyzer { return elevenlabs.Analyzer{Cfg: cfg} },
+	"fastly":        func(cfg *config.Config) analyzers.Analyzer { return fastly.Analyzer{Cfg: cfg} },
+	"figma":         func(cfg *config.Config) analyzers.Analyzer { return figma.Analyzer{Cfg: cfg} },
+	"github":        func(cfg *config.Config) analyzers.Analyzer { return github.Analyzer{Cfg: cfg} },
+	"gitlab":        func(cfg *config.Config) analyzers.Analyzer { return gitlab.Analyzer{Cfg: cfg} },
+	"groq":          func(cfg *config.Config) analyzers.Analyzer { return groq.Analyzer{Cfg: cfg} },
+	"huggingface":   func(cfg *config.Config) analyzers.Analyzer { return huggingface.Analyzer{Cfg: cfg} },
+	"jira":          func(cfg *config.Config) analyzers.Analyzer { return jira.Analyzer{Cfg: cfg} },
+	"launchdarkly":  func(cfg *config.Config) analyzers.Analyzer { return launchdarkly.Analyzer{Cfg: cfg} },
+	"mailchimp":     func(cfg *config.Config) analyzers.Analyzer { return mailchimp.Analyzer{Cfg: cfg} },
+	"mailgun":       func(cfg *config.Config) analyzers.Analyzer { return mailgun.Analyzer{Cfg: cfg} },
+	"monday":        func(cfg *config.Config) analyzers.Analyzer { return monday.Analyzer{Cfg: cfg} },
+	"mux":           func(cfg *config.Config) analyzers.Analyzer { return mux.Analyzer{Cfg: cfg} },
+	"mysql":         func(cfg *config.Config) analyzers.Analyzer { return mysql.Analyzer{Cfg: cfg} },
+	"netlify":       func(cfg *config.Config) analyzers.Analyzer { return netlify.Analyzer{Cfg: cfg} },
+	"ngrok":         func(cfg *config.Config) analyzers.Analyzer { return ngrok.Analyzer{Cfg: cfg} },
+	"notion":        func(cfg *config.Config) analyzers.Analyzer { return notion.Analyzer{Cfg: cfg} },
+	"openai":        func(cfg *config.Config) analyzers.Analyzer { return openai.Analyzer{Cfg: cfg} },
+	"opsgenie":      func(cfg *config.Config) analyzers.Analyzer { return opsgenie.Analyzer{Cfg: cfg} },
+	"plaid":         func(cfg *config.Config) analyzers.Analyzer { return plaid.Analyzer{Cfg: cfg} },
+	"planetscale":   func(cfg *config.Config) analyzers.Analyzer { return planetscale.Analyzer{Cfg: cfg} },
+	"postgres":      func(cfg *config.Config) analyzers.Analyzer { return postgres.Analyzer{Cfg: cfg} },
+	"posthog":       func(cfg *config.Config) analyzers.Analyzer { return posthog.Analyzer{Cfg: cfg} },
+	"postman":       func(cfg *config.Config) analyzers.Analyzer { return postman.Analyzer{Cfg: cfg} },
+	"privatekey":    func(cfg *config.Config) analyzers.Analyzer { return privatekey.Analyzer{Cfg: cfg} },
+	"sendgrid":      func(cfg *config.Config) analyzers.Analyzer { return sendgrid.Analyzer{Cfg: cfg} },
+	"shopify":       func(cfg *config.Config) analyzers.Analyzer { return shopify.Analyzer{Cfg: cfg} },
+	"slack":         func(cfg *config.Config) analyzers.Analyzer { return slack.Analyzer{Cfg: cfg} },
+	"sourcegraph":   func(cfg *config.Config) analyzers.Analyzer { return sourcegraph.Analyzer{Cfg: cfg} },
+	"square":        func(cfg *config.Config) analyzers.Analyzer { return square.Analyzer{Cfg: cfg} },
+	"stripe":        func(cfg *config.Config) analyzers.Analyzer { return stripe.Analyzer{Cfg: cfg} },
+	"twilio":        func(cfg *config.Config) analyzers.Analyzer { return &twilio.Analyzer{Cfg: cfg} },
+}
+
+// credentialInfoKeys maps the part names collected by the CLI and TUI to the
+// credential info keys an analyzer's Analyze method expects, for the analyzers
+// where the two differ.
+var credentialInfoKeys = map[string]map[string]string{
+	"airtableoauth": {"key": "token"},
+	"airtablepat":   {"key": "token"},
+	"dropbox":       {"key": "token"},
+	"figma":         {"key": "token"},
+	"mysql":         {"key": "connection_string"},
+	"postgres":      {"key": "connection_string"},
+	"privatekey":    {"key": "token"},
+	"shopify":       {"url": "store_url"},
+}
+
+// Result is the machine-readable outcome of analyzing a credential.
+type Result struct {
+	AnalyzerType       string
+	Bindings           []analyzers.Binding
+	UnboundedResources []analyzers.Resource
+	Metadata           map[string]any
+}
+
+// Analyze runs the analyzer for keyType and returns its structured resources,
+// permissions and bindings instead of printing them.
+func Analyze(ctx context.Context, keyType string, secretInfo SecretInfo) (*Result, error) {
+	if secretInfo.Cfg == nil {
+		secretInfo.Cfg = &config.Config{}
+	}
+	keyType = strings.ToLower(keyType)
+	newAnalyzer, ok := analyzerFactories[keyType]
+	if !ok {
+		return nil, fmt.Errorf("unknown analyzer type %q", keyType)
+	}
+
+	credInfo := maps.Clone(secretInfo.Parts)
+	if credInfo == nil {
+		credInfo = make(map[string]string)
+	}
+	for part, key := range credentialInfoKeys[keyType] {
+		if value, ok := credInfo[part]; ok {
+			delete(credInfo, part)
+			credInfo[key] = value
+		}
+	}
+
+	a := newAnalyzer(secretInfo.Cfg)
+	res, err := a.Analyze(ctx, credInfo)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{AnalyzerType: a.Type().String()}
+	if res != nil {
+		result.Bindings = res.Bindings
+		result.UnboundedResources = res.UnboundedResources
+		result.Metadata = res.Metadata
+	}
+	return result, nil
+}
+
+// detectorAnalyzers maps the detectors whose verified results carry
+// AnalysisInfo to the analyzer for them. The AnalysisInfo keys set by each
+// detector match what its analyzer's Analyze method expects. Only analyzers
+// that send every request through analyzers.NewAnalyzeClient and keep no
+// package-level state are listed, since AnalyzeResult runs concurrently from
+// the engine's notifier workers. Analyzers that bypass the client (airtable,
+// figma, openai) or share state between calls (openai, sendgrid) are left out.
+var detectorAnalyzers = map[detectorspb.DetectorType]string{
+	detectorspb.DetectorType_Anthropic:           "anthropic",
+	detectorspb.DetectorType_AsanaOauth:          "asana",
+	detectorspb.DetectorType_DatabricksToken:     "databricks",
+	detectorspb.DetectorType_DatadogToken:        "datadog",
+	detectorspb.DetectorType_DigitalOceanToken:   "digitalocean",
+	detectorspb.DetectorType_DigitalOceanV2:      "digitalocean",
+	detectorspb.DetectorType_ElevenLabs:          "elevenlabs",
+	detectorspb.DetectorType_FastlyPersonalToken: "fastly",
+	detectorspb.DetectorType_Github:              "github",
+	detectorspb.DetectorType_Gitlab:              "gitlab",
+	detectorspb.DetectorType_Groq:                "groq",
+	detectorspb.DetectorType_HuggingFace:         "huggingface",
+	detectorspb.DetectorType_LaunchDarkly:        "launchdarkly",
+	detectorspb.DetectorType_Mailchimp:           "mailchimp",
+	detectorspb.DetectorType_Mailgun:             "mailgun",
+	detectorspb.DetectorType_Netlify:             "netlify",
+	detectorspb.DetectorType_Ngrok:               "ngrok",
+	detectorspb.DetectorType_PlanetScale:         "planetscale",
+	detectorspb.DetectorType_Postgres:            "postgres",
+	detectorspb.DetectorType_PosthogApp:          "posthog",
+	detectorspb.DetectorType_Postman:             "postman",
+	detectorspb.DetectorType_PrivateKey:          "privatekey",
+	detectorspb.DetectorType_Shopify:             "shopify",
+	detectorspb.DetectorType_Slack:               "slack",
+	detectorspb.DetectorType_Stripe:              "stripe",
+	detectorspb.DetectorType_Twilio:              "twilio",
+	detectorspb.DetectorType_TwilioApiKey:        "twilio",
+}
+
+// CanAnalyze reports whether AnalyzeResult supports results of the detector.
+func CanAnalyze(detectorType detectorspb.DetectorType) bool {
+	_, ok := detectorAnalyzers[detectorType]
+	return ok
+}
+
+// AnalyzeResult runs the analyzer matching a detector result on the result's
+// AnalysisInfo and summarizes the permissions it finds. It returns nil if the
+// result cannot be analyzed. Analysis failures are reported in the summary's
+// Error field.
+func AnalyzeResult(ctx context.Context, result detectors.Result) *detectors.AnalysisSummary {
+	name, ok := detectorAnalyzers[result.DetectorType]
+	if !ok || len(result.AnalysisInfo) == 0 {
+		return nil
+	}
+	a := analyzerFactories[name](&config.Config{})
+	summary := &detectors.AnalysisSummary{AnalyzerType: a.Type().String()}
+
+	res, err := a.Analyze(ctx, maps.Clone(result.AnalysisInfo))
+	if err != nil {
+		summary.Error = err.Error()
+		return summary
+	}
+	if res != nil {
+		summary.Permissions = summarizeBindings(res.Bindings)
+	}
+	return summary
+}
+
+// summarizeBindings groups bindings by resource, in the order the resources
+// first appear.
+func summarizeBindings(bindings []analyzers.Binding) []detectors.ResourcePermissions {
+	var summary []detectors.ResourcePermissions
+	index := make(map[string]int)
+	for _, binding := range bindings {
+		resource := binding.Resource.FullyQualifiedName
+		if resource == "" {
+			resource = binding.Resource.Name
+		}
+		i, ok := index[resource]
+		if !ok {
+			i = len(summary)
+			index[resource] = i
+			summary = append(summary, detectors.ResourcePermissions{
+				Resource:     resource,
+				ResourceType: binding.Resource.Type,
+			})
+		}
+		if !slices.Contains(summary[i].Permissions, binding.Permission.Value) {
+			summary[i].Permissions = append(summary[i].Permissions, binding.Permission.Value)
+		}
+	}
+	for i := range summary {
+		slices.Sort(summary[i].Permissions)
+	}
+	return summary
+}
@@ -157,8 +157,7 @@ func (h *HttpStatusTest) RunTest(client *http.Client, baseURL string, headers ma
 	_, statusCode, err := makeDataDogRequest(client, baseURL, h.Endpoint, h.Method, apiKey, appKey)
 
 	if err != nil {
-		fmt.Printf("Error making request: %v\n", err)
-		return false, err
+		return false, fmt.Errorf("error making request: %w", err)
 	}
 
 	// Check response status code
@@ -114,8 +114,7 @@ func GetAllGistsForUser(client *gh.Client) ([]*gh.Gist, error) {
 		opt.Page = page
 		gists, resp, err := client.Gists.List(context.Background(), "", opt)
 		if err != nil {
-			color.Red("Error getting gists.")
-			return nil, err
+			return nil, fmt.Errorf("error getting gists: %w", err)
 		}
 		allGists = append(allGists, gists...)
 
@@ -138,8 +137,7 @@ func GetAllReposForUser(client *gh.Client) ([]*gh.Repository, error) {
 		opt.Page = page
 		repos, resp, err := client.Repositories.ListByAuthenticatedUser(context.Background(), opt)
 		if err != nil {
-			color.Red("Error getting repos.")
-			return nil, err
+			return nil, fmt.Errorf("error getting repos: %w", err)
 		}
 		allRepos = append(allRepos, repos...)
 
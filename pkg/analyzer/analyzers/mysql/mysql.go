@@ -337,7 +337,6 @@ func AnalyzePermissions(cfg *config.Config, connectionStr string) (*SecretInfo,
 func parseConnectionStr(connection string) (*dburl.URL, error) {
 	// Check if the connection string starts with 'mysql://'
 	if !strings.HasPrefix(connection, "mysql://") {
-		color.Yellow("[i] The connection string should start with 'mysql://'. Adding it for you.")
 		connection = "mysql://" + connection
 	}
 
@@ -642,7 +641,6 @@ func parseDBFromGrant(grant string) string {
 	// Split on " ON "
 	parts := strings.Split(grant, " ON ")
 	if len(parts) < 2 {
-		color.Red("[!] Error processing grant: %s", grant)
 		return ""
 	}
 
@@ -681,7 +679,7 @@ func filterTablePrivs(privs []string) []string {
 func addRemoveOnePrivOnAll(databases map[string]*Database, globalPrivs *GlobalPrivs, priv string, isGrant bool) {
 	scope, ok := SCOPES[priv]
 	if !ok {
-		color.Red("[!] Error processing grant: privilege doesn't exist in our MySQL (%s)", priv)
+		// The privilege doesn't exist in our MySQL scopes.
 		return
 	}
 
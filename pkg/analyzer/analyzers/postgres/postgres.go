@@ -348,7 +348,6 @@ func createConnection(params map[string]string, database string) (*sql.DB, error
 		defer delete(params, pg_sslmode) // We want to return with the original params map intact (for ExtraData)
 		return createConnection(params, database)
 	case isErrorDatabaseNotFound(err, params[pg_dbname], params[pg_user]):
-		color.Green("[!] Successfully connected to Postgres database.")
 		return nil, err
 	default:
 		return nil, err
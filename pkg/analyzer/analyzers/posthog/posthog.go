@@ -173,8 +173,7 @@ func (h *HttpStatusTest) RunTest(cfg *config.Config, client *http.Client, domain
 	case StatusContains(resp.StatusCode, h.InvalidStatuses):
 		return false, nil
 	default:
-		fmt.Println(h.Method, h.Endpoint)
-		return false, errors.New("error checking response status code")
+		return false, fmt.Errorf("error checking response status code %d for %s %s", resp.StatusCode, h.Method, h.Endpoint)
 	}
 }
 
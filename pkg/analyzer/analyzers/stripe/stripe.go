@@ -171,10 +171,7 @@ func (h *HttpStatusTest) RunTest(cfg *config.Config, headers map[string]string)
 	case StatusContains(resp.StatusCode, h.InvalidStatuses):
 		return false, nil
 	default:
-		fmt.Println(h)
-		fmt.Println(resp.Body)
-		fmt.Println(resp.StatusCode)
-		return false, errors.New("error checking response status code")
+		return false, fmt.Errorf("error checking response status code %d for %s %s", resp.StatusCode, h.Method, h.Endpoint)
 	}
 }
 
@@ -315,8 +312,7 @@ func AnalyzeAndPrintPermissions(cfg *config.Config, key string) {
 func getRestrictedPermissions(cfg *config.Config, key string) ([]PermissionsCategory, error) {
 	var config Config
 	if err := yaml.Unmarshal(restrictedConfig, &config); err != nil {
-		fmt.Println("Error unmarshalling YAML:", err)
-		return nil, err
+		return nil, fmt.Errorf("error unmarshalling YAML: %w", err)
 	}
 
 	output := make([]PermissionsCategory, 0)
@@ -333,8 +329,7 @@ func getRestrictedPermissions(cfg *config.Config, key string) ([]PermissionsCate
 				testCount++
 				status, err := test.RunTest(cfg, map[string]string{"Authorization": "Bearer " + key})
 				if err != nil {
-					color.Red("[x] Error running test: %s", err.Error())
-					return nil, err
+					return nil, fmt.Errorf("error running test: %w", err)
 				}
 				if status {
 					value = typ
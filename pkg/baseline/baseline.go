@@ -0,0 +1,187 @@
+// Package baseline records fingerprints of known findings so that later scans
+// can suppress them instead of reporting them again.
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// currentVersion is the version of the baseline file format written by this package.
+const currentVersion = 1
+
+// volatileMetadataKeys are source metadata fields that describe a finding but
+// not where it lives. They are left out of the fingerprint so that, for
+// example, a repository changing visibility doesn't resurface every finding.
+var volatileMetadataKeys = map[string]struct{}{
+	"link":       {},
+	"visibility": {},
+}
+
+// Finding is a single entry in a baseline file. Only the fingerprint is used
+// for matching; the remaining fields make the file reviewable by humans.
+type Finding struct {
+	Fingerprint  string `json:"fingerprint"`
+	DetectorName string `json:"detector_name"`
+	SourceName   string `json:"source_name,omitempty"`
+	Redacted     string `json:"redacted,omitempty"`
+	Verified     bool   `json:"verified"`
+}
+
+type file struct {
+	Version  int       `json:"version"`
+	Findings []Finding `json:"findings"`
+}
+
+// Baseline is a set of previously accepted findings. It is safe for concurrent use.
+type Baseline struct {
+	mu       sync.RWMutex
+	findings map[string]Finding
+}
+
+// New creates an empty Baseline.
+func New() *Baseline {
+	return &Baseline{findings: make(map[string]Finding)}
+}
+
+// Load reads a baseline file from the provided path.
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading baseline file: %w", err)
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("error parsing baseline file: %w", err)
+	}
+	if f.Version != currentVersion {
+		return nil, fmt.Errorf("unsupported baseline version %d", f.Version)
+	}
+
+	b := New()
+	for _, finding := range f.Findings {
+		if finding.Fingerprint == "" {
+			return nil, errors.New("baseline finding is missing a fingerprint")
+		}
+		b.findings[finding.Fingerprint] = finding
+	}
+	return b, nil
+}
+
+// Contains reports whether the result is already recorded in the baseline.
+func (b *Baseline) Contains(r *detectors.ResultWithMetadata) bool {
+	fp, err := Fingerprint(r)
+	if err != nil {
+		return false
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	_, ok := b.findings[fp]
+	return ok
+}
+
+// Add records the result in the baseline.
+func (b *Baseline) Add(r *detectors.ResultWithMetadata) error {
+	fp, err := Fingerprint(r)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.findings[fp] = Finding{
+		Fingerprint:  fp,
+		DetectorName: r.DetectorType.String(),
+		SourceName:   r.SourceName,
+		Redacted:     r.Redacted,
+		Verified:     r.Verified,
+	}
+	return nil
+}
+
+// Len returns the number of findings in the baseline.
+func (b *Baseline) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.findings)
+}
+
+// WriteFile writes the baseline to the provided path. Findings are sorted by
+// fingerprint so that rewriting an unchanged baseline produces no diff.
+func (b *Baseline) WriteFile(path string) error {
+	b.mu.RLock()
+	f := file{Version: currentVersion, Findings: make([]Finding, 0, len(b.findings))}
+	for _, finding := range b.findings {
+		f.Findings = append(f.Findings, finding)
+	}
+	b.mu.RUnlock()
+
+	sort.Slice(f.Findings, func(i, j int) bool {
+		return f.Findings[i].Fingerprint < f.Findings[j].Fingerprint
+	})
+
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling baseline: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("error writing baseline file: %w", err)
+	}
+	return nil
+}
+
+// Fingerprint returns a stable identifier for a result, derived from the
+// detector type, the raw secret (RawV2 when available) and the source location.
+func Fingerprint(r *detectors.ResultWithMetadata) (string, error) {
+	raw := r.RawV2
+	if len(raw) == 0 {
+		raw = r.Raw
+	}
+
+	location, err := sourceLocation(r)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(r.DetectorType.String()))
+	h.Write([]byte{0})
+	h.Write(raw)
+	h.Write([]byte{0})
+	h.Write(location)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// sourceLocation serializes the result's source metadata, minus volatile
+// fields, into a deterministic byte representation.
+func sourceLocation(r *detectors.ResultWithMetadata) ([]byte, error) {
+	if r.SourceMetadata == nil || r.SourceMetadata.Data == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(r.SourceMetadata.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling source metadata: %w", err)
+	}
+	var meta map[string]map[string]any
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return nil, fmt.Errorf("error unmarshalling source metadata: %w", err)
+	}
+	for _, fields := range meta {
+		for k := range volatileMetadataKeys {
+			delete(fields, k)
+		}
+	}
+	// encoding/json sorts map keys, so the output is deterministic.
+	return json.Marshal(meta)
+}
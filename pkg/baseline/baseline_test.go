@@ -0,0 +1,99 @@
+package baseline
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+func githubResult(file string, line int64, raw string) *detectors.ResultWithMetadata {
+	return &detectors.ResultWithMetadata{
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: &source_metadatapb.Github{
+					Commit: "abc123",
+					File:   file,
+					Line:   line,
+					Link:   "https://example.com/" + file,
+				},
+			},
+		},
+		Result: detectors.Result{
+			DetectorType: detectorspb.DetectorType_AWS,
+			Raw:          []byte(raw),
+			Redacted:     "AKIA...",
+		},
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	base, err := Fingerprint(githubResult("a.txt", 1, "secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		result *detectors.ResultWithMetadata
+		same   bool
+	}{
+		{name: "identical", result: githubResult("a.txt", 1, "secret"), same: true},
+		{name: "different file", result: githubResult("b.txt", 1, "secret")},
+		{name: "different line", result: githubResult("a.txt", 2, "secret")},
+		{name: "different secret", result: githubResult("a.txt", 1, "other")},
+		{
+			name: "different link",
+			result: func() *detectors.ResultWithMetadata {
+				r := githubResult("a.txt", 1, "secret")
+				r.SourceMetadata.GetGithub().Link = "https://mirror.example.com/a.txt"
+				return r
+			}(),
+			same: true,
+		},
+		{
+			name: "rawV2 preferred",
+			result: func() *detectors.ResultWithMetadata {
+				r := githubResult("a.txt", 1, "secret")
+				r.RawV2 = []byte("secret:id")
+				return r
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Fingerprint(tt.result)
+			require.NoError(t, err)
+			if tt.same {
+				assert.Equal(t, base, got)
+			} else {
+				assert.NotEqual(t, base, got)
+			}
+		})
+	}
+}
+
+func TestBaseline_RoundTrip(t *testing.T) {
+	b := New()
+	require.NoError(t, b.Add(githubResult("a.txt", 1, "secret")))
+	require.NoError(t, b.Add(githubResult("b.txt", 3, "secret")))
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	require.NoError(t, b.WriteFile(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, loaded.Len())
+	assert.True(t, loaded.Contains(githubResult("a.txt", 1, "secret")))
+	assert.True(t, loaded.Contains(githubResult("b.txt", 3, "secret")))
+	assert.False(t, loaded.Contains(githubResult("c.txt", 1, "secret")))
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
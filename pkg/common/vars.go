@@ -60,7 +60,6 @@ var (
 		"wmv":  {},
 
 		// documents
-		"pdf": {},
 		"psd": {},
 
 		// fonts
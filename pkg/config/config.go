@@ -10,6 +10,8 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/protoyaml"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/bitbucket"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/confluence"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/docker"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/filesystem"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gcs"
@@ -17,8 +19,10 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/github"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/gitlab"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jenkins"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/jira"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/postman"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/s3"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/slack"
 )
 
 // Config holds user supplied configuration.
@@ -103,6 +107,14 @@ func instantiateSourceFromType(sourceType string) (sources.Source, error) {
 		source = new(gcs.Source)
 	case sourcespb.SourceType_SOURCE_TYPE_DOCKER.String():
 		source = new(docker.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_BITBUCKET.String():
+		source = new(bitbucket.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE.String():
+		source = new(confluence.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_JIRA.String():
+		source = new(jira.Source)
+	case sourcespb.SourceType_SOURCE_TYPE_SLACK.String():
+		source = new(slack.Source)
 	default:
 		return nil, fmt.Errorf("got unexpected source type: %q", sourceType)
 	}
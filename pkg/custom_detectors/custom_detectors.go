@@ -2,11 +2,15 @@ package custom_detectors
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"regexp"
+	"slices"
 	"strings"
 
 	"golang.org/x/sync/errgroup"
@@ -22,6 +26,13 @@ import (
 // for poorly defined regexps.
 const maxTotalMatches = 100
 
+// The look-back used for detectors without a max_credential_span. It matches
+// the span the engine gives detectors that don't provide one.
+const defaultCredentialSpan = 512
+
+// The maximum number of bytes read from a verification response.
+const maxResponseSize = 1 << 20
+
 // CustomRegexWebhook is a CustomRegex with webhook validation that is
 // guaranteed to be valid (assuming the data is not changed after
 // initialization).
@@ -33,6 +44,8 @@ type CustomRegexWebhook struct {
 var _ detectors.Detector = (*CustomRegexWebhook)(nil)
 var _ detectors.CustomFalsePositiveChecker = (*CustomRegexWebhook)(nil)
 var _ detectors.MaxSecretSizeProvider = (*CustomRegexWebhook)(nil)
+var _ detectors.MultiPartCredentialProvider = (*CustomRegexWebhook)(nil)
+var _ detectors.CustomResultsCleaner = (*CustomRegexWebhook)(nil)
 
 // NewWebhookCustomRegex initializes and validates a CustomRegexWebhook. An
 // unexported type is intentionally returned here to ensure the values have
@@ -45,6 +58,15 @@ func NewWebhookCustomRegex(pb *custom_detectorspb.CustomRegex) (*CustomRegexWebh
 	if err := ValidateRegex(pb.Regex); err != nil {
 		return nil, err
 	}
+	if err := ValidateMaxCredentialSpan(pb.MaxCredentialSpan); err != nil {
+		return nil, err
+	}
+	if err := ValidateFalsePositives(pb.FalsePositives); err != nil {
+		return nil, err
+	}
+	if err := ValidateCleaningPolicy(pb.CleaningPolicy); err != nil {
+		return nil, err
+	}
 
 	for _, verify := range pb.Verify {
 		if err := ValidateVerifyEndpoint(verify.Endpoint, verify.Unsafe); err != nil {
@@ -53,6 +75,19 @@ func NewWebhookCustomRegex(pb *custom_detectorspb.CustomRegex) (*CustomRegexWebh
 		if err := ValidateVerifyHeaders(verify.Headers); err != nil {
 			return nil, err
 		}
+		if err := ValidateVerifyRanges(verify.SuccessRanges); err != nil {
+			return nil, err
+		}
+		if err := ValidateVerifyMethod(verify.Method); err != nil {
+			return nil, err
+		}
+		templates := append([]string{verify.Endpoint, verify.Body}, verify.Headers...)
+		if err := ValidateRegexVars(pb.Regex, templates...); err != nil {
+			return nil, err
+		}
+		if err := ValidateResponseMatchers(verify.ResponseMatchers); err != nil {
+			return nil, err
+		}
 	}
 
 	// TODO: Copy only necessary data out of pb.
@@ -64,27 +99,20 @@ var httpClient = common.SaneHttpClient()
 func (c *CustomRegexWebhook) FromData(ctx context.Context, verify bool, data []byte) (results []detectors.Result, err error) {
 	dataStr := string(data)
 	regexMatches := make(map[string][][]string, len(c.GetRegex()))
+	regexLocations := make(map[string][][]int, len(c.GetRegex()))
 
 	// Compile exclude regexes targeting the capture group
-	excludeRegexesCapture := make([]*regexp.Regexp, 0, len(c.GetExcludeRegexesCapture()))
-	for _, exclude := range c.GetExcludeRegexesCapture() {
-		regex, err := regexp.Compile(exclude)
-		if err != nil {
-			// This will only happen if the regex is invalid.
-			return nil, err
-		}
-		excludeRegexesCapture = append(excludeRegexesCapture, regex)
+	excludeRegexesCapture, err := compileRegexes(c.GetExcludeRegexesCapture())
+	if err != nil {
+		// This will only happen if the regex is invalid.
+		return nil, err
 	}
 
 	// Compile exclude regexes targeting the entire match
-	excludeRegexes := make([]*regexp.Regexp, 0, len(c.GetExcludeRegexesMatch()))
-	for _, exclude := range c.GetExcludeRegexesMatch() {
-		regex, err := regexp.Compile(exclude)
-		if err != nil {
-			// This will only happen if the regex is invalid.
-			return nil, err
-		}
-		excludeRegexes = append(excludeRegexes, regex)
+	excludeRegexes, err := compileRegexes(c.GetExcludeRegexesMatch())
+	if err != nil {
+		// This will only happen if the regex is invalid.
+		return nil, err
 	}
 
 	// Find all submatches for each regex.
@@ -94,7 +122,8 @@ func (c *CustomRegexWebhook) FromData(ctx context.Context, verify bool, data []b
 			// This will only happen if the regex is invalid.
 			return nil, err
 		}
-		regexMatches[name] = regex.FindAllStringSubmatch(dataStr, -1)
+		regexLocations[name] = regex.FindAllStringSubmatchIndex(dataStr, -1)
+		regexMatches[name] = submatches(dataStr, regexLocations[name])
 	}
 
 	// Permutate each individual match.
@@ -107,7 +136,14 @@ func (c *CustomRegexWebhook) FromData(ctx context.Context, verify bool, data []b
 	//    {"foo": ["match1"], "bar": ["match2"]},
 	//    {"foo": ["match1"], "bar": ["match3"]},
 	// ]
-	matches := permutateMatches(regexMatches)
+	// With a max_credential_span, only matches close enough to each other
+	// are combined.
+	var matches []map[string][]string
+	if span := c.GetMaxCredentialSpan(); span > 0 {
+		matches = permutateMatchesWithinSpan(regexMatches, regexLocations, span)
+	} else {
+		matches = permutateMatches(regexMatches)
+	}
 
 	g := new(errgroup.Group)
 
@@ -117,39 +153,9 @@ func (c *CustomRegexWebhook) FromData(ctx context.Context, verify bool, data []b
 MatchLoop:
 	for _, match := range matches {
 		for _, values := range match {
-			// attempt to use capture group
-			secret := values[0]
-			if len(values) > 1 {
-				secret = values[1]
-			}
-
-			// check entropy
-			entropy := c.GetEntropy()
-			if entropy > 0.0 && detectors.StringShannonEntropy(secret) < float64(entropy) {
+			if c.excludedBy(values, excludeRegexes, excludeRegexesCapture) != "" {
 				continue MatchLoop
 			}
-
-			// check for exclude words
-			for _, excludeWord := range c.GetExcludeWords() {
-				if strings.Contains(strings.ToLower(secret), excludeWord) {
-					continue MatchLoop
-				}
-			}
-
-			// exclude checks
-			for _, excludeMatch := range excludeRegexes {
-				if excludeMatch.MatchString(values[0]) {
-					continue MatchLoop
-				}
-			}
-
-			// exclude secret (capture group), or if no capture group is set,
-			// check against entire match.
-			for _, excludeSecret := range excludeRegexesCapture {
-				if excludeSecret.MatchString(secret) {
-					continue MatchLoop
-				}
-			}
 		}
 
 		g.Go(func() error {
@@ -172,15 +178,102 @@ MatchLoop:
 	return results, nil
 }
 
-func (c *CustomRegexWebhook) IsFalsePositive(_ detectors.Result) (bool, string) {
-	return false, ""
+func compileRegexes(patterns []string) ([]*regexp.Regexp, error) {
+	regexes := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		regexes = append(regexes, regex)
+	}
+	return regexes, nil
+}
+
+// excludedBy returns the filter that excludes the values of a regex match, or
+// an empty string if the match is kept.
+func (c *CustomRegexWebhook) excludedBy(values []string, excludeRegexes, excludeRegexesCapture []*regexp.Regexp) string {
+	// attempt to use capture group
+	secret := values[0]
+	if len(values) > 1 {
+		secret = values[1]
+	}
+
+	// check entropy
+	entropy := c.GetEntropy()
+	if entropy > 0.0 && detectors.StringShannonEntropy(secret) < float64(entropy) {
+		return fmt.Sprintf("entropy %.2f", entropy)
+	}
+
+	// check for exclude words
+	for _, excludeWord := range c.GetExcludeWords() {
+		if strings.Contains(strings.ToLower(secret), excludeWord) {
+			return fmt.Sprintf("exclude_words %q", excludeWord)
+		}
+	}
+
+	// exclude checks
+	for _, excludeMatch := range excludeRegexes {
+		if excludeMatch.MatchString(values[0]) {
+			return fmt.Sprintf("exclude_regexes_match %q", excludeMatch)
+		}
+	}
+
+	// exclude secret (capture group), or if no capture group is set,
+	// check against entire match.
+	for _, excludeSecret := range excludeRegexesCapture {
+		if excludeSecret.MatchString(secret) {
+			return fmt.Sprintf("exclude_regexes_capture %q", excludeSecret)
+		}
+	}
+	return ""
+}
+
+// IsFalsePositive checks the result against the detector's false_positives
+// wordlist. Without one, no result is a false positive.
+func (c *CustomRegexWebhook) IsFalsePositive(result detectors.Result) (bool, string) {
+	if len(c.GetFalsePositives()) == 0 {
+		return false, ""
+	}
+	falsePositives := make(map[detectors.FalsePositive]struct{}, len(c.GetFalsePositives()))
+	for _, word := range c.GetFalsePositives() {
+		falsePositives[detectors.FalsePositive(strings.ToLower(word))] = struct{}{}
+	}
+	return detectors.IsKnownFalsePositive(string(result.Raw), falsePositives, false)
 }
 
 // custom max size for custom detector
 func (c *CustomRegexWebhook) MaxSecretSize() int64 {
+	if span := c.GetMaxCredentialSpan(); span > 0 {
+		return span
+	}
 	return 1000
 }
 
+// MaxCredentialSpan returns the detector's max_credential_span, or the
+// engine's usual look-back if it has none.
+func (c *CustomRegexWebhook) MaxCredentialSpan() int64 {
+	if span := c.GetMaxCredentialSpan(); span > 0 {
+		return span
+	}
+	return defaultCredentialSpan
+}
+
+// CleanResults applies the default cleaning logic, unless the detector's
+// cleaning_policy is NEVER.
+func (c *CustomRegexWebhook) CleanResults(results []detectors.Result) []detectors.Result {
+	if c.GetCleaningPolicy() == custom_detectorspb.CustomRegex_NEVER {
+		return results
+	}
+	return detectors.CleanResults(results)
+}
+
+// ShouldCleanResultsIrrespectiveOfConfiguration reports whether the detector's
+// cleaning_policy is ALWAYS.
+func (c *CustomRegexWebhook) ShouldCleanResultsIrrespectiveOfConfiguration() bool {
+	return c.GetCleaningPolicy() == custom_detectorspb.CustomRegex_ALWAYS
+}
+
 func (c *CustomRegexWebhook) createResults(ctx context.Context, match map[string][]string, verify bool, results chan<- detectors.Result) error {
 	if common.IsDone(ctx) {
 		// TODO: Log we're possibly leaving out results.
@@ -218,63 +311,49 @@ func (c *CustomRegexWebhook) createResults(ctx context.Context, match map[string
 			return nil
 		}
 	}
-	// Verify via webhook.
-	jsonBody, err := json.Marshal(map[string]map[string][]string{
-		c.GetName(): match,
-	})
-	if err != nil {
-		// This should never happen, but if it does, return nil to not
-		// disrupt other verification.
-		return nil
-	}
-	// Try each config until we successfully verify.
+	// Try each config until we successfully verify. A verification error is
+	// only reported if no config could tell whether the secret is valid.
+	var (
+		verifyErr  error
+		determined bool
+	)
 	for _, verifyConfig := range c.GetVerify() {
 		if common.IsDone(ctx) {
 			// TODO: Log we're possibly leaving out results.
 			return ctx.Err()
 		}
-		req, err := http.NewRequestWithContext(ctx, "POST", verifyConfig.GetEndpoint(), bytes.NewReader(jsonBody))
+		outcome, body, err := c.verify(ctx, verifyConfig, match)
 		if err != nil {
+			verifyErr = err
 			continue
 		}
-		for _, header := range verifyConfig.GetHeaders() {
-			key, value, found := strings.Cut(header, ":")
-			if !found {
-				// Should be unreachable due to validation.
-				continue
-			}
-			req.Header.Add(key, strings.TrimLeft(value, "\t\n\v\f\r "))
-		}
-		resp, err := httpClient.Do(req)
-		if err != nil {
-			continue
-		}
-		defer func() {
-			_, _ = io.Copy(io.Discard, resp.Body)
-			_ = resp.Body.Close()
-		}()
 
-		if resp.StatusCode == http.StatusOK {
+		switch outcome {
+		case custom_detectorspb.ResponseMatcher_VERIFIED:
 			// mark the result as verified
 			result.Verified = true
+		case custom_detectorspb.ResponseMatcher_UNVERIFIED:
+			determined = true
+			continue
+		default:
+			verifyErr = fmt.Errorf("unable to determine verification status from %s", verifyConfig.GetEndpoint())
+			continue
+		}
 
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				continue
-			}
-
-			// TODO: handle different content-type responses seperatly when implement custom detector configurations
-			responseStr := string(body)
-			// truncate to 200 characters if response length exceeds 200
-			if len(responseStr) > 200 {
-				responseStr = responseStr[:200]
-			}
+		// TODO: handle different content-type responses seperatly when implement custom detector configurations
+		responseStr := string(body)
+		// truncate to 200 characters if response length exceeds 200
+		if len(responseStr) > 200 {
+			responseStr = responseStr[:200]
+		}
 
-			// store the processed response in ExtraData
-			result.ExtraData["response"] = responseStr
+		// store the processed response in ExtraData
+		result.ExtraData["response"] = responseStr
 
-			break
-		}
+		break
+	}
+	if !result.Verified && !determined {
+		result.SetVerificationError(verifyErr, raw)
 	}
 
 	select {
@@ -285,6 +364,69 @@ func (c *CustomRegexWebhook) createResults(ctx context.Context, match map[string
 	}
 }
 
+// verify sends the verification request described by verifyConfig for a
+// match and decides the outcome from the response.
+func (c *CustomRegexWebhook) verify(ctx context.Context, verifyConfig *custom_detectorspb.VerifierConfig, match map[string][]string) (custom_detectorspb.ResponseMatcher_Result, []byte, error) {
+	req, err := c.newVerifyRequest(ctx, verifyConfig, match)
+	if err != nil {
+		return custom_detectorspb.ResponseMatcher_UNKNOWN, nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return custom_detectorspb.ResponseMatcher_UNKNOWN, nil, err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return custom_detectorspb.ResponseMatcher_UNKNOWN, nil, err
+	}
+	return verificationOutcome(verifyConfig, resp.StatusCode, body), body, nil
+}
+
+// newVerifyRequest builds the verification request for a match. Variables in
+// the endpoint, headers and body are replaced with the named regex matches;
+// values in the endpoint are URL-escaped. If no body is configured, POST
+// requests send all the matches as JSON, keyed by the detector name.
+func (c *CustomRegexWebhook) newVerifyRequest(ctx context.Context, verifyConfig *custom_detectorspb.VerifierConfig, match map[string][]string) (*http.Request, error) {
+	method := verifyConfig.GetMethod()
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	var body io.Reader
+	switch {
+	case verifyConfig.GetBody() != "":
+		body = strings.NewReader(NewRegexVarString(verifyConfig.GetBody()).Render(match, nil))
+	case method == http.MethodPost:
+		jsonBody, err := json.Marshal(map[string]map[string][]string{
+			c.GetName(): match,
+		})
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(jsonBody)
+	}
+
+	endpoint := NewRegexVarString(verifyConfig.GetEndpoint()).Render(match, url.QueryEscape)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range verifyConfig.GetHeaders() {
+		key, value, found := strings.Cut(NewRegexVarString(header).Render(match, nil), ":")
+		if !found {
+			// Should be unreachable due to validation.
+			continue
+		}
+		req.Header.Add(key, strings.TrimLeft(value, "\t\n\v\f\r "))
+	}
+	return req, nil
+}
+
 func (c *CustomRegexWebhook) Keywords() []string {
 	return c.GetKeywords()
 }
@@ -350,6 +492,93 @@ func permutateMatches(regexMatches map[string][][]string) []map[string][]string
 	return matches
 }
 
+// permutateMatchesWithinSpan is like permutateMatches, but only returns the
+// permutations whose matches all fit within maxSpan characters of the data.
+// regexLocations holds the location of each match, as returned by
+// FindAllStringSubmatchIndex.
+//
+// The matches are sorted by offset and each one in turn starts a window of
+// maxSpan characters. Only the matches in that window are combined, with the
+// first match as the earliest part, so every permutation within the span is
+// found once no matter how many matches there are in total.
+func permutateMatchesWithinSpan(regexMatches map[string][][]string, regexLocations map[string][][]int, maxSpan int64) []map[string][]string {
+	type location struct {
+		name       string
+		index      int
+		start, end int
+	}
+	var locations []location
+	for name, matches := range regexLocations {
+		if len(matches) == 0 {
+			return nil
+		}
+		for i, match := range matches {
+			locations = append(locations, location{name: name, index: i, start: match[0], end: match[1]})
+		}
+	}
+	slices.SortFunc(locations, func(a, b location) int {
+		return cmp.Or(cmp.Compare(a.start, b.start), cmp.Compare(a.name, b.name), cmp.Compare(a.index, b.index))
+	})
+
+	var matches []map[string][]string
+	for i, first := range locations {
+		limit := first.start + int(maxSpan)
+		if first.end > limit {
+			continue
+		}
+		window := map[string][]location{first.name: {first}}
+		for _, next := range locations[i+1:] {
+			if next.start > limit {
+				break
+			}
+			if next.name != first.name && next.end <= limit {
+				window[next.name] = append(window[next.name], next)
+			}
+		}
+		if len(window) < len(regexMatches) {
+			continue
+		}
+
+		names := make([]string, 0, len(window))
+		lengths := make([]int, 0, len(window))
+		for name, parts := range window {
+			names = append(names, name)
+			lengths = append(lengths, len(parts))
+		}
+		for _, permutation := range productIndices(lengths...) {
+			candidate := make(map[string][]string, len(names))
+			for j, name := range names {
+				candidate[name] = regexMatches[name][window[name][permutation[j]].index]
+			}
+			matches = append(matches, candidate)
+			if len(matches) == maxTotalMatches {
+				return matches
+			}
+		}
+	}
+
+	return matches
+}
+
+// submatches returns the strings FindAllStringSubmatch would return for the
+// locations returned by FindAllStringSubmatchIndex.
+func submatches(data string, locations [][]int) [][]string {
+	if locations == nil {
+		return nil
+	}
+	matches := make([][]string, 0, len(locations))
+	for _, location := range locations {
+		match := make([]string, len(location)/2)
+		for i := range match {
+			if location[2*i] >= 0 {
+				match[i] = data[location[2*i]:location[2*i+1]]
+			}
+		}
+		matches = append(matches, match)
+	}
+	return matches
+}
+
 func (c *CustomRegexWebhook) Type() detectorspb.DetectorType {
 	return detectorspb.DetectorType_CustomRegex
 }
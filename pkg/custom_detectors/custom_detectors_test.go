@@ -2,10 +2,19 @@ package custom_detectors
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/protoyaml"
 )
@@ -104,6 +113,25 @@ func TestCustomDetectorsParsing(t *testing.T) {
 	assert.Equal(t, []string{"Authorization: Bearer token"}, got.Verify[0].Headers)
 }
 
+func TestCustomRegexMultiPartParsing(t *testing.T) {
+	testCustomRegexYaml := `name: Internal client
+keywords:
+- client
+regex:
+  id: client_id=([a-z0-9]{8})
+  secret: client_secret=([a-z0-9]{8})
+max_credential_span: 256
+false_positives:
+- dummy
+cleaning_policy: NEVER`
+
+	var got custom_detectorspb.CustomRegex
+	assert.NoError(t, protoyaml.UnmarshalStrict([]byte(testCustomRegexYaml), &got))
+	assert.Equal(t, int64(256), got.MaxCredentialSpan)
+	assert.Equal(t, []string{"dummy"}, got.FalsePositives)
+	assert.Equal(t, custom_detectorspb.CustomRegex_NEVER, got.CleaningPolicy)
+}
+
 func TestFromData_InvalidRegEx(t *testing.T) {
 	c := &CustomRegexWebhook{
 		&custom_detectorspb.CustomRegex{
@@ -227,8 +255,215 @@ func TestDetectorPrimarySecret(t *testing.T) {
 	assert.Equal(t, "secret_YI7C90ACY1_yy", results[0].GetPrimarySecretValue())
 }
 
+func TestDetectorMaxCredentialSpan(t *testing.T) {
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:              "test",
+		Keywords:          []string{"client"},
+		Regex:             map[string]string{"id": `client_id=([a-z0-9]{8})`, "secret": `client_secret=([a-z0-9]{8})`},
+		MaxCredentialSpan: 80,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(80), detector.MaxCredentialSpan())
+
+	filler := strings.Repeat("#\n", 50)
+	data := "client_id=aaaaaaaa client_secret=11111111\n" + filler +
+		"client_id=bbbbbbbb client_secret=22222222\n" + filler +
+		"client_id=cccccccc client_secret=33333333\n"
+	results, err := detector.FromData(context.Background(), false, []byte(data))
+	require.NoError(t, err)
+
+	// Without the span, every id would be paired with every secret. The raw
+	// value joins both parts in no particular order.
+	var pairs []string
+	for _, result := range results {
+		parts := []string{string(result.Raw[:8]), string(result.Raw[8:])}
+		sort.Strings(parts)
+		pairs = append(pairs, strings.Join(parts, ","))
+	}
+	assert.ElementsMatch(t, []string{"11111111,aaaaaaaa", "22222222,bbbbbbbb", "33333333,cccccccc"}, pairs)
+}
+
+func TestDetectorMaxCredentialSpanManyMatches(t *testing.T) {
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:              "test",
+		Keywords:          []string{"client"},
+		Regex:             map[string]string{"id": `client_id=([0-9]{8})`, "secret": `client_secret=([a-z0-9]{8})`},
+		MaxCredentialSpan: 80,
+	})
+	require.NoError(t, err)
+
+	// Far more id and secret pairs than could be checked one by one, with
+	// the only pair that fits in the span at the very end.
+	var data strings.Builder
+	for i := range 300 {
+		fmt.Fprintf(&data, "client_id=%08d\n", i)
+	}
+	data.WriteString(strings.Repeat("#\n", 50))
+	for i := range 300 {
+		fmt.Fprintf(&data, "client_secret=s%07d\n", i)
+	}
+	data.WriteString(strings.Repeat("#\n", 50))
+	data.WriteString("client_id=99999999 client_secret=zzzzzzzz\n")
+
+	results, err := detector.FromData(context.Background(), false, []byte(data.String()))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Contains(t, string(results[0].Raw), "99999999")
+	assert.Contains(t, string(results[0].Raw), "zzzzzzzz")
+}
+
+func TestDetectorDefaultCredentialSpan(t *testing.T) {
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:     "test",
+		Keywords: []string{"token"},
+		Regex:    map[string]string{"token": `token=([a-z]+)`},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(512), detector.MaxCredentialSpan())
+}
+
+func TestDetectorFalsePositives(t *testing.T) {
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:           "test",
+		Keywords:       []string{"token"},
+		Regex:          map[string]string{"token": `token=([a-zA-Z0-9]+)`},
+		FalsePositives: []string{"Dummy"},
+	})
+	require.NoError(t, err)
+
+	isFalsePositive, reason := detector.IsFalsePositive(detectors.Result{Raw: []byte("myDUMMYtoken")})
+	assert.True(t, isFalsePositive)
+	assert.Equal(t, "contains term: dummy", reason)
+
+	// Unlike the default check, words that aren't in the list are kept.
+	isFalsePositive, _ = detector.IsFalsePositive(detectors.Result{Raw: []byte("example")})
+	assert.False(t, isFalsePositive)
+}
+
+func TestDetectorCleaningPolicy(t *testing.T) {
+	results := []detectors.Result{{Raw: []byte("a")}, {Raw: []byte("b")}}
+	tests := []struct {
+		policy          custom_detectorspb.CustomRegex_CleaningPolicy
+		wantIgnoreFlags bool
+		wantLen         int
+	}{
+		{policy: custom_detectorspb.CustomRegex_DEFAULT, wantLen: 1},
+		{policy: custom_detectorspb.CustomRegex_ALWAYS, wantIgnoreFlags: true, wantLen: 1},
+		{policy: custom_detectorspb.CustomRegex_NEVER, wantLen: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy.String(), func(t *testing.T) {
+			detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+				Name:           "test",
+				Keywords:       []string{"token"},
+				Regex:          map[string]string{"token": `token=([a-z]+)`},
+				CleaningPolicy: tt.policy,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIgnoreFlags, detector.ShouldCleanResultsIrrespectiveOfConfiguration())
+			assert.Len(t, detector.CleanResults(append([]detectors.Result(nil), results...)), tt.wantLen)
+		})
+	}
+}
+
 func BenchmarkProductIndices(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_ = productIndices(3, 2, 6)
 	}
 }
+
+func TestDetectorVerification(t *testing.T) {
+	type request struct {
+		method, path, auth, body string
+	}
+	var got request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = request{r.Method, r.URL.RequestURI(), r.Header.Get("Authorization"), string(body)}
+		switch r.Header.Get("Authorization") {
+		case "Bearer tok_valid12345":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"data":{"active":true,"scopes":["read"]}}`))
+		case "Bearer tok_revoked123":
+			_, _ = w.Write([]byte(`{"data":{"active":false}}`))
+		case "Bearer tok_limited123":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`rate limit exceeded`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	verifier := &custom_detectorspb.VerifierConfig{
+		Endpoint:      server.URL + "/check/{id.1}",
+		Unsafe:        true,
+		Method:        http.MethodPut,
+		Headers:       []string{"Authorization: Bearer {token.1}"},
+		Body:          `{"token": "{token.1}"}`,
+		SuccessRanges: []string{"200-299"},
+		ResponseMatchers: []*custom_detectorspb.ResponseMatcher{
+			{JsonPath: "$.data.active", Value: "false", Result: custom_detectorspb.ResponseMatcher_UNVERIFIED},
+			{Regex: "rate limit", Result: custom_detectorspb.ResponseMatcher_UNKNOWN},
+		},
+	}
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:     "internal",
+		Keywords: []string{"tok_"},
+		Regex:    map[string]string{"token": `(tok_[a-z0-9]{10})`, "id": `id=([a-z0-9/]+)`},
+		Verify:   []*custom_detectorspb.VerifierConfig{verifier},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		token        string
+		wantVerified bool
+		wantErr      bool
+	}{
+		{name: "verified", token: "tok_valid12345", wantVerified: true},
+		{name: "unverified by matcher", token: "tok_revoked123"},
+		{name: "unknown by matcher", token: "tok_limited123", wantErr: true},
+		{name: "unverified by status", token: "tok_invalid123"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := detector.FromData(context.Background(), true, []byte("id=a/b "+tt.token))
+			require.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, tt.wantVerified, results[0].Verified)
+			assert.Equal(t, tt.wantErr, results[0].VerificationError() != nil)
+			assert.Equal(t, request{
+				method: http.MethodPut,
+				path:   "/check/a%2Fb",
+				auth:   "Bearer " + tt.token,
+				body:   `{"token": "` + tt.token + `"}`,
+			}, got)
+		})
+	}
+}
+
+func TestDetectorVerificationLegacyWebhook(t *testing.T) {
+	var body map[string]map[string][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	}))
+	defer server.Close()
+
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:     "internal",
+		Keywords: []string{"tok_"},
+		Regex:    map[string]string{"token": `(tok_[a-z0-9]{10})`},
+		Verify:   []*custom_detectorspb.VerifierConfig{{Endpoint: server.URL, Unsafe: true}},
+	})
+	require.NoError(t, err)
+
+	results, err := detector.FromData(context.Background(), true, []byte("tok_valid12345"))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, results[0].Verified)
+	assert.Equal(t, map[string]map[string][]string{
+		"internal": {"token": {"tok_valid12345", "tok_valid12345"}},
+	}, body)
+}
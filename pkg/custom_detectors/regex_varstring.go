@@ -24,15 +24,9 @@ func NewRegexVarString(original string) RegexVarString {
 
 	matches := nameGroupRegex.FindAllStringSubmatch(original, -1)
 	for _, match := range matches {
-		name, group := match[1], 0
-		// The second match will start with a period followed by any number
-		// of whitespace.
-		if len(match[2]) > 1 {
-			g, err := strconv.Atoi(strings.TrimSpace(match[2][1:]))
-			if err != nil {
-				continue
-			}
-			group = g
+		name, group, ok := parseVariable(match)
+		if !ok {
+			continue
 		}
 		variables[name] = group
 	}
@@ -42,3 +36,39 @@ func NewRegexVarString(original string) RegexVarString {
 		variables: variables,
 	}
 }
+
+// Render replaces every variable with the group it refers to in match, passing
+// each value through escape if it is not nil. Variables with a name that is not
+// in match are left as they are, and variables referring to a group that the
+// match does not have are replaced with an empty string.
+func (r RegexVarString) Render(match map[string][]string, escape func(string) string) string {
+	return nameGroupRegex.ReplaceAllStringFunc(r.original, func(variable string) string {
+		name, group, ok := parseVariable(nameGroupRegex.FindStringSubmatch(variable))
+		values, found := match[name]
+		if !ok || !found {
+			return variable
+		}
+		if group >= len(values) {
+			return ""
+		}
+		if escape == nil {
+			return values[group]
+		}
+		return escape(values[group])
+	})
+}
+
+// parseVariable returns the name and group of a nameGroupRegex submatch.
+func parseVariable(match []string) (string, int, bool) {
+	name, group := match[1], 0
+	// The second match will start with a period followed by any number
+	// of whitespace.
+	if len(match[2]) > 1 {
+		g, err := strconv.Atoi(strings.TrimSpace(match[2][1:]))
+		if err != nil {
+			return "", 0, false
+		}
+		group = g
+	}
+	return name, group, true
+}
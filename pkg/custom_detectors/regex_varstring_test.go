@@ -1,6 +1,7 @@
 package custom_detectors
 
 import (
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,3 +75,48 @@ func TestVarString(t *testing.T) {
 		})
 	}
 }
+
+func TestVarStringRender(t *testing.T) {
+	match := map[string][]string{
+		"id":    {"id=a b", "a b"},
+		"token": {"tok_123"},
+	}
+	tests := []struct {
+		name   string
+		input  string
+		escape func(string) string
+		want   string
+	}{
+		{
+			name:  "default group",
+			input: "Bearer {token}",
+			want:  "Bearer tok_123",
+		},
+		{
+			name:  "capture group",
+			input: "{ id . 1 }:{token.0}",
+			want:  "a b:tok_123",
+		},
+		{
+			name:  "missing group",
+			input: "[{token.1}]",
+			want:  "[]",
+		},
+		{
+			name:  "unknown variable",
+			input: "{other} {token}",
+			want:  "{other} tok_123",
+		},
+		{
+			name:   "escaped",
+			input:  "/users/{id.1}",
+			escape: url.QueryEscape,
+			want:   "/users/a+b",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewRegexVarString(tt.input).Render(match, tt.escape))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package custom_detectors
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+// verificationOutcome decides the result of a verification request from the
+// response status and body. Matchers are tried in order and the first one that
+// matches decides the outcome. If none match, the result is verified when the
+// status is in one of the success ranges and unverified otherwise.
+func verificationOutcome(config *custom_detectorspb.VerifierConfig, status int, body []byte) custom_detectorspb.ResponseMatcher_Result {
+	// The body is only decoded if a matcher needs it.
+	var (
+		decoded   any
+		decodeErr error
+		isDecoded bool
+	)
+	for _, matcher := range config.GetResponseMatchers() {
+		if matcher.GetJsonPath() == "" {
+			if matchRegex(matcher.GetRegex(), string(body)) {
+				return matcher.GetResult()
+			}
+			continue
+		}
+
+		if !isDecoded {
+			decodeErr = json.Unmarshal(body, &decoded)
+			isDecoded = true
+		}
+		if decodeErr != nil {
+			continue
+		}
+		path, err := parseJSONPath(matcher.GetJsonPath())
+		if err != nil {
+			// Should be unreachable due to validation.
+			continue
+		}
+		value, ok := lookupJSONPath(decoded, path)
+		if !ok {
+			continue
+		}
+		switch {
+		case matcher.GetValue() != "":
+			ok = jsonValueString(value) == matcher.GetValue()
+		case matcher.GetRegex() != "":
+			ok = matchRegex(matcher.GetRegex(), jsonValueString(value))
+		}
+		if ok {
+			return matcher.GetResult()
+		}
+	}
+
+	if statusInRanges(config.GetSuccessRanges(), status) {
+		return custom_detectorspb.ResponseMatcher_VERIFIED
+	}
+	return custom_detectorspb.ResponseMatcher_UNVERIFIED
+}
+
+func matchRegex(pattern, s string) bool {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		// Should be unreachable due to validation.
+		return false
+	}
+	return regex.MatchString(s)
+}
+
+// statusInRanges reports whether status is in one of the ranges, which are
+// either single codes ("200") or inclusive ranges ("200-299"). With no ranges,
+// only 200 is a success.
+func statusInRanges(ranges []string, status int) bool {
+	if len(ranges) == 0 {
+		return status == 200
+	}
+	for _, successRange := range ranges {
+		lower, upper, found := strings.Cut(successRange, "-")
+		if !found {
+			upper = lower
+		}
+		lowerBound, err := strconv.Atoi(lower)
+		if err != nil {
+			continue
+		}
+		upperBound, err := strconv.Atoi(upper)
+		if err != nil {
+			continue
+		}
+		if status >= lowerBound && status <= upperBound {
+			return true
+		}
+	}
+	return false
+}
+
+// parseJSONPath splits a path like "$.data.users[0].active" or
+// "data.users.0.active" into its keys.
+func parseJSONPath(original string) ([]string, error) {
+	path := strings.TrimPrefix(strings.TrimPrefix(original, "$"), ".")
+	if path == "" {
+		return nil, nil
+	}
+	path = strings.ReplaceAll(path, "[", ".")
+	path = strings.ReplaceAll(path, "]", "")
+
+	keys := strings.Split(path, ".")
+	for _, key := range keys {
+		if key == "" {
+			return nil, fmt.Errorf("invalid json path %q", original)
+		}
+	}
+	return keys, nil
+}
+
+// lookupJSONPath returns the value at path in a decoded JSON document.
+func lookupJSONPath(doc any, path []string) (any, bool) {
+	for _, key := range path {
+		switch v := doc.(type) {
+		case map[string]any:
+			value, ok := v[key]
+			if !ok {
+				return nil, false
+			}
+			doc = value
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			doc = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// jsonValueString returns strings as they are and any other value as JSON, so
+// that a matcher's value can be compared against "true", "42" or "null".
+func jsonValueString(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,73 @@
+package custom_detectors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+func TestVerificationOutcome(t *testing.T) {
+	config := &custom_detectorspb.VerifierConfig{
+		SuccessRanges: []string{"200", "204-206"},
+		ResponseMatchers: []*custom_detectorspb.ResponseMatcher{
+			{JsonPath: "$.token.active", Value: "false", Result: custom_detectorspb.ResponseMatcher_UNVERIFIED},
+			{JsonPath: "errors[0].code", Regex: "^5", Result: custom_detectorspb.ResponseMatcher_UNKNOWN},
+			{JsonPath: "token.scopes.1", Value: "admin", Result: custom_detectorspb.ResponseMatcher_VERIFIED},
+			{Regex: "(?i)maintenance", Result: custom_detectorspb.ResponseMatcher_UNKNOWN},
+		},
+	}
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   custom_detectorspb.ResponseMatcher_Result
+	}{
+		{
+			name:   "success status",
+			status: 200,
+			body:   `{"token":{"active":true}}`,
+			want:   custom_detectorspb.ResponseMatcher_VERIFIED,
+		},
+		{
+			name:   "success range",
+			status: 205,
+			want:   custom_detectorspb.ResponseMatcher_VERIFIED,
+		},
+		{
+			name:   "failure status",
+			status: 201,
+			want:   custom_detectorspb.ResponseMatcher_UNVERIFIED,
+		},
+		{
+			name:   "json value",
+			status: 200,
+			body:   `{"token":{"active":false}}`,
+			want:   custom_detectorspb.ResponseMatcher_UNVERIFIED,
+		},
+		{
+			name:   "json regex",
+			status: 200,
+			body:   `{"errors":[{"code":503}]}`,
+			want:   custom_detectorspb.ResponseMatcher_UNKNOWN,
+		},
+		{
+			name:   "json array index",
+			status: 403,
+			body:   `{"token":{"scopes":["read","admin"]}}`,
+			want:   custom_detectorspb.ResponseMatcher_VERIFIED,
+		},
+		{
+			name:   "body regex",
+			status: 200,
+			body:   `Down for Maintenance`,
+			want:   custom_detectorspb.ResponseMatcher_UNKNOWN,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, verificationOutcome(config, tt.status, []byte(tt.body)))
+		})
+	}
+}
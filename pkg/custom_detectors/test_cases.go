@@ -0,0 +1,118 @@
+package custom_detectors
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// TestCaseResult is the outcome of running a detector against one of the
+// samples in its should_match or should_not_match lists.
+type TestCaseResult struct {
+	Detector    string `json:"detector"`
+	Sample      string `json:"sample"`
+	ShouldMatch bool   `json:"should_match"`
+	Matched     bool   `json:"matched"`
+	// Rules describes the keyword, regex and exclude rules that fired or
+	// failed for the sample, in the order they were checked.
+	Rules []string `json:"rules"`
+}
+
+// Passed reports whether the detector matched the sample as expected.
+func (r TestCaseResult) Passed() bool {
+	return r.Matched == r.ShouldMatch
+}
+
+// RunTestCases runs the detector against its should_match and should_not_match
+// samples. Results are never verified, so no requests are made.
+func (c *CustomRegexWebhook) RunTestCases(ctx context.Context) ([]TestCaseResult, error) {
+	excludeRegexes, err := compileRegexes(c.GetExcludeRegexesMatch())
+	if err != nil {
+		return nil, err
+	}
+	excludeRegexesCapture, err := compileRegexes(c.GetExcludeRegexesCapture())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TestCaseResult, 0, len(c.GetShouldMatch())+len(c.GetShouldNotMatch()))
+	for _, sample := range c.GetShouldMatch() {
+		result, err := c.runTestCase(ctx, sample, true, excludeRegexes, excludeRegexesCapture)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	for _, sample := range c.GetShouldNotMatch() {
+		result, err := c.runTestCase(ctx, sample, false, excludeRegexes, excludeRegexesCapture)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (c *CustomRegexWebhook) runTestCase(ctx context.Context, sample string, shouldMatch bool, excludeRegexes, excludeRegexesCapture []*regexp.Regexp) (TestCaseResult, error) {
+	result := TestCaseResult{
+		Detector:    c.GetName(),
+		Sample:      sample,
+		ShouldMatch: shouldMatch,
+	}
+
+	// The engine only runs a detector on chunks containing one of its
+	// keywords, ignoring case.
+	lowerSample := strings.ToLower(sample)
+	keyword := ""
+	for _, kw := range c.GetKeywords() {
+		if strings.Contains(lowerSample, strings.ToLower(kw)) {
+			keyword = kw
+			break
+		}
+	}
+	if keyword == "" {
+		result.Rules = append(result.Rules, "no keyword found")
+		return result, nil
+	}
+	result.Rules = append(result.Rules, fmt.Sprintf("keyword %q found", keyword))
+
+	names := make([]string, 0, len(c.GetRegex()))
+	for name := range c.GetRegex() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		regex, err := regexp.Compile(c.GetRegex()[name])
+		if err != nil {
+			// This will only happen if the regex is invalid.
+			return result, err
+		}
+		matches := regex.FindAllStringSubmatch(sample, -1)
+		if len(matches) == 0 {
+			result.Rules = append(result.Rules, fmt.Sprintf("regex %q did not match", name))
+			continue
+		}
+		for _, values := range matches {
+			if by := c.excludedBy(values, excludeRegexes, excludeRegexesCapture); by != "" {
+				result.Rules = append(result.Rules, fmt.Sprintf("regex %q match %q excluded by %s", name, values[0], by))
+				continue
+			}
+			result.Rules = append(result.Rules, fmt.Sprintf("regex %q matched %q", name, values[0]))
+		}
+	}
+
+	detected, err := c.FromData(ctx, false, []byte(sample))
+	if err != nil {
+		return result, err
+	}
+	for _, r := range detected {
+		if isFalsePositive, reason := c.IsFalsePositive(r); isFalsePositive {
+			result.Rules = append(result.Rules, fmt.Sprintf("result %q is a false positive: %s", r.Raw, reason))
+			continue
+		}
+		result.Matched = true
+	}
+	return result, nil
+}
@@ -0,0 +1,75 @@
+package custom_detectors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
+
+func TestRunTestCases(t *testing.T) {
+	detector, err := NewWebhookCustomRegex(&custom_detectorspb.CustomRegex{
+		Name:         "hog",
+		Keywords:     []string{"HOG"},
+		Regex:        map[string]string{"id": `hog_id=([a-z]+)`, "token": `hog_token=([a-zA-Z0-9]{8})`},
+		ExcludeWords: []string{"example"},
+		ShouldMatch: []string{
+			"hog_id=pig hog_token=AbCd1234",
+			"hog_id=pig",
+		},
+		ShouldNotMatch: []string{
+			"hog_id=example hog_token=AbCd1234",
+			"id=pig token=AbCd1234",
+		},
+	})
+	require.NoError(t, err)
+
+	results, err := detector.RunTestCases(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []TestCaseResult{
+		{
+			Detector:    "hog",
+			Sample:      "hog_id=pig hog_token=AbCd1234",
+			ShouldMatch: true,
+			Matched:     true,
+			Rules: []string{
+				`keyword "HOG" found`,
+				`regex "id" matched "hog_id=pig"`,
+				`regex "token" matched "hog_token=AbCd1234"`,
+			},
+		},
+		{
+			Detector:    "hog",
+			Sample:      "hog_id=pig",
+			ShouldMatch: true,
+			Rules: []string{
+				`keyword "HOG" found`,
+				`regex "id" matched "hog_id=pig"`,
+				`regex "token" did not match`,
+			},
+		},
+		{
+			Detector: "hog",
+			Sample:   "hog_id=example hog_token=AbCd1234",
+			Rules: []string{
+				`keyword "HOG" found`,
+				`regex "id" match "hog_id=example" excluded by exclude_words "example"`,
+				`regex "token" matched "hog_token=AbCd1234"`,
+			},
+		},
+		{
+			Detector: "hog",
+			Sample:   "id=pig token=AbCd1234",
+			Rules:    []string{"no keyword found"},
+		},
+	}, results)
+
+	var passed []bool
+	for _, result := range results {
+		passed = append(passed, result.Passed())
+	}
+	assert.Equal(t, []bool{true, false, true, true}, passed)
+}
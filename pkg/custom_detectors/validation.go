@@ -2,9 +2,12 @@ package custom_detectors
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
 )
 
 func ValidateKeywords(keywords []string) error {
@@ -99,11 +102,77 @@ func ValidateVerifyRanges(ranges []string) error {
 func ValidateRegexVars(regex map[string]string, body ...string) error {
 	for _, b := range body {
 		matches := NewRegexVarString(b).variables
-		for match := range matches {
-			if _, ok := regex[match]; !ok {
+		for match, group := range matches {
+			reg, ok := regex[match]
+			if !ok {
 				return fmt.Errorf("body %q contains an unknown variable", b)
 			}
+			compiled, err := regexp.Compile(reg)
+			if err != nil {
+				return fmt.Errorf("regex '%s': %w", match, err)
+			}
+			if group > compiled.NumSubexp() {
+				return fmt.Errorf("body %q refers to a group regex '%s' does not have", b, match)
+			}
+		}
+	}
+	return nil
+}
+
+func ValidateVerifyMethod(method string) error {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return nil
+	}
+	return fmt.Errorf("unsupported http method %q", method)
+}
+
+func ValidateResponseMatchers(matchers []*custom_detectorspb.ResponseMatcher) error {
+	for i, matcher := range matchers {
+		if matcher.GetJsonPath() == "" && matcher.GetRegex() == "" {
+			return fmt.Errorf("response matcher %d must have a json_path or a regex", i)
+		}
+		if matcher.GetValue() != "" && matcher.GetJsonPath() == "" {
+			return fmt.Errorf("response matcher %d has a value but no json_path", i)
+		}
+		if matcher.GetValue() != "" && matcher.GetRegex() != "" {
+			return fmt.Errorf("response matcher %d can only have one of value and regex", i)
+		}
+		if _, err := regexp.Compile(matcher.GetRegex()); err != nil {
+			return fmt.Errorf("response matcher %d regex: %w", i, err)
+		}
+		if _, err := parseJSONPath(matcher.GetJsonPath()); err != nil {
+			return fmt.Errorf("response matcher %d: %w", i, err)
 		}
+		if matcher.GetResult() == custom_detectorspb.ResponseMatcher_RESULT_UNSPECIFIED {
+			return fmt.Errorf("response matcher %d must have a result", i)
+		}
+		if _, ok := custom_detectorspb.ResponseMatcher_Result_name[int32(matcher.GetResult())]; !ok {
+			return fmt.Errorf("response matcher %d has an unknown result %d", i, matcher.GetResult())
+		}
+	}
+	return nil
+}
+
+func ValidateMaxCredentialSpan(span int64) error {
+	if span < 0 {
+		return fmt.Errorf("max_credential_span must not be negative, got %d", span)
+	}
+	return nil
+}
+
+func ValidateFalsePositives(words []string) error {
+	for i, word := range words {
+		if strings.TrimSpace(word) == "" {
+			return fmt.Errorf("false positive %d is empty", i)
+		}
+	}
+	return nil
+}
+
+func ValidateCleaningPolicy(policy custom_detectorspb.CustomRegex_CleaningPolicy) error {
+	if _, ok := custom_detectorspb.CustomRegex_CleaningPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("unknown cleaning_policy %d", policy)
 	}
 	return nil
 }
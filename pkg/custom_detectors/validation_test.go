@@ -1,6 +1,10 @@
 package custom_detectors
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/custom_detectorspb"
+)
 
 func TestCustomDetectorsKeywordValidation(t *testing.T) {
 	tests := []struct {
@@ -221,6 +225,12 @@ func TestCustomDetectorsVerifyRegexVarsValidation(t *testing.T) {
 			body:    "hello world {hello}",
 			wantErr: true,
 		},
+		{
+			name:    "Regex var refers to a group that does not exist",
+			regex:   map[string]string{"id": "[0-9]{1,10}", "id_pat_example": "([a-zA-Z0-9]{32})"},
+			body:    "hello world {id_pat_example.2}",
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -232,3 +242,173 @@ func TestCustomDetectorsVerifyRegexVarsValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestCustomDetectorsVerifyMethodValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		wantErr bool
+	}{
+		{
+			name:    "Test default method",
+			method:  "",
+			wantErr: false,
+		},
+		{
+			name:    "Test GET method",
+			method:  "GET",
+			wantErr: false,
+		},
+		{
+			name:    "Test lowercase method",
+			method:  "get",
+			wantErr: true,
+		},
+		{
+			name:    "Test unknown method",
+			method:  "TRACE",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateVerifyMethod(tt.method)
+
+			if (got != nil && !tt.wantErr) || (got == nil && tt.wantErr) {
+				t.Errorf("ValidateVerifyMethod() error = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomDetectorsResponseMatchersValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		matchers []*custom_detectorspb.ResponseMatcher
+		wantErr  bool
+	}{
+		{
+			name: "Test json path and regex matchers",
+			matchers: []*custom_detectorspb.ResponseMatcher{
+				{JsonPath: "$.data[0].active", Value: "true", Result: custom_detectorspb.ResponseMatcher_VERIFIED},
+				{JsonPath: "error.code", Regex: "^4[0-9]{2}$", Result: custom_detectorspb.ResponseMatcher_UNVERIFIED},
+				{Regex: "rate limit", Result: custom_detectorspb.ResponseMatcher_UNKNOWN},
+			},
+			wantErr: false,
+		},
+		{
+			name:     "Test empty matcher",
+			matchers: []*custom_detectorspb.ResponseMatcher{{}},
+			wantErr:  true,
+		},
+		{
+			name:     "Test missing result",
+			matchers: []*custom_detectorspb.ResponseMatcher{{JsonPath: "$.data[0].active"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Test value without json path",
+			matchers: []*custom_detectorspb.ResponseMatcher{{Regex: "ok", Value: "true"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Test value and regex",
+			matchers: []*custom_detectorspb.ResponseMatcher{{JsonPath: "ok", Regex: "ok", Value: "true"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Test invalid regex",
+			matchers: []*custom_detectorspb.ResponseMatcher{{Regex: "(ok"}},
+			wantErr:  true,
+		},
+		{
+			name:     "Test invalid json path",
+			matchers: []*custom_detectorspb.ResponseMatcher{{JsonPath: "data..active"}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateResponseMatchers(tt.matchers)
+
+			if (got != nil && !tt.wantErr) || (got == nil && tt.wantErr) {
+				t.Errorf("ValidateResponseMatchers() error = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomDetectorsMaxCredentialSpanValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		span    int64
+		wantErr bool
+	}{
+		{
+			name:    "Test unset span",
+			span:    0,
+			wantErr: false,
+		},
+		{
+			name:    "Test positive span",
+			span:    256,
+			wantErr: false,
+		},
+		{
+			name:    "Test negative span",
+			span:    -1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateMaxCredentialSpan(tt.span)
+
+			if (got != nil && !tt.wantErr) || (got == nil && tt.wantErr) {
+				t.Errorf("ValidateMaxCredentialSpan() error = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomDetectorsFalsePositivesValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		words   []string
+		wantErr bool
+	}{
+		{
+			name:    "Test words",
+			words:   []string{"dummy", "changeme"},
+			wantErr: false,
+		},
+		{
+			name:    "Test empty word",
+			words:   []string{"dummy", ""},
+			wantErr: true,
+		},
+		{
+			name:    "Test blank word",
+			words:   []string{" "},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateFalsePositives(tt.words)
+
+			if (got != nil && !tt.wantErr) || (got == nil && tt.wantErr) {
+				t.Errorf("ValidateFalsePositives() error = %v, wantErr %v", got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCustomDetectorsCleaningPolicyValidation(t *testing.T) {
+	if err := ValidateCleaningPolicy(custom_detectorspb.CustomRegex_NEVER); err != nil {
+		t.Errorf("ValidateCleaningPolicy() error = %v, wantErr false", err)
+	}
+	if err := ValidateCleaningPolicy(custom_detectorspb.CustomRegex_CleaningPolicy(42)); err == nil {
+		t.Errorf("ValidateCleaningPolicy() error = nil, wantErr true")
+	}
+}
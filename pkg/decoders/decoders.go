@@ -12,6 +12,10 @@ func DefaultDecoders() []Decoder {
 		&Base64{},
 		&UTF16{},
 		&EscapedUnicode{},
+		&Hex{},
+		&URLEncoded{},
+		&HTMLEntity{},
+		&QuotedPrintable{},
 	}
 }
 
@@ -27,6 +31,54 @@ type Decoder interface {
 	Type() detectorspb.DecoderType
 }
 
+// newDecodableChunk returns a copy of chunk with its data replaced by the
+// decoded data. The original chunk is left untouched so that it can be passed
+// to the next decoder.
+func newDecodableChunk(chunk *sources.Chunk, data []byte, decoderType detectorspb.DecoderType) *DecodableChunk {
+	return &DecodableChunk{
+		DecoderType: decoderType,
+		Chunk: &sources.Chunk{
+			Data:           data,
+			SourceName:     chunk.SourceName,
+			SourceID:       chunk.SourceID,
+			JobID:          chunk.JobID,
+			SecretID:       chunk.SecretID,
+			SourceMetadata: chunk.SourceMetadata,
+			SourceType:     chunk.SourceType,
+			Verify:         chunk.Verify,
+		},
+	}
+}
+
+// isPrintableText reports whether b consists only of printable ASCII and
+// whitespace, which is what decoded secrets look like. Decoders use it to
+// reject data that merely looks encoded, such as hashes.
+func isPrintableText(b []byte) bool {
+	for _, c := range b {
+		if !isPrintableByte(c) && c != '\t' && c != '\n' && c != '\r' {
+			return false
+		}
+	}
+	return true
+}
+
+// isHexDigit reports whether c is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// unhex returns the value of the hexadecimal digit c.
+func unhex(c byte) byte {
+	switch {
+	case '0' <= c && c <= '9':
+		return c - '0'
+	case 'a' <= c && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
+
 // Fuzz is an entrypoint for go-fuzz, which is an AFL-style fuzzing tool.
 // This one attempts to uncover any panics during decoding.
 func Fuzz(data []byte) int {
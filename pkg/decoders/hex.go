@@ -0,0 +1,125 @@
+package decoders
+
+import (
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// Hex decodes hex-encoded text: runs of hex digits, such as the output of
+// `xxd -p` or a hex-dumped config blob, and \xNN escape sequences. Only runs
+// that decode to printable text are replaced, so hex hashes and IDs are left
+// as they are.
+type Hex struct{}
+
+var _ Decoder = (*Hex)(nil)
+
+// minHexRunLen is the minimum number of hex digits in a run that is decoded.
+// Shorter runs are too common in plain text, and decode to fewer bytes than
+// almost any secret.
+const minHexRunLen = 32
+
+var hexEscapePat = regexp.MustCompile(`(?:\\x[0-9a-fA-F]{2}){4,}`)
+
+func (d *Hex) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_HEX
+}
+
+func (d *Hex) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || len(chunk.Data) == 0 {
+		return nil
+	}
+
+	data, escapesDecoded := decodeHexEscapes(chunk.Data)
+	data, runsDecoded := decodeHexRuns(data)
+	if !escapesDecoded && !runsDecoded {
+		return nil
+	}
+	return newDecodableChunk(chunk, data, d.Type())
+}
+
+// decodeHexEscapes replaces sequences of \xNN escapes that decode to
+// printable text. The input is not modified.
+func decodeHexEscapes(data []byte) ([]byte, bool) {
+	decoded := false
+	out := hexEscapePat.ReplaceAllFunc(data, func(match []byte) []byte {
+		text := make([]byte, 0, len(match)/4)
+		for i := 0; i+3 < len(match); i += 4 {
+			text = append(text, unhex(match[i+2])<<4|unhex(match[i+3]))
+		}
+		if !isPrintableText(text) {
+			return match
+		}
+		decoded = true
+		return text
+	})
+	return out, decoded
+}
+
+// decodeHexRuns replaces runs of at least minHexRunLen hex digits that decode
+// to printable text. A run may span several lines, as long as every line but
+// the last is itself long enough to be a run, which is how hex dumps wrap.
+// The input is not modified.
+func decodeHexRuns(data []byte) ([]byte, bool) {
+	var (
+		out     []byte
+		digits  []byte
+		last    int // end of the data already copied to out
+		decoded bool
+	)
+	for i := 0; i < len(data); {
+		if !isHexDigit(data[i]) {
+			i++
+			continue
+		}
+
+		start, end := i, i
+		digits = digits[:0]
+		for {
+			lineStart := end
+			for end < len(data) && isHexDigit(data[end]) {
+				end++
+			}
+			digits = append(digits, data[lineStart:end]...)
+
+			// Continue the run on the next line if this line is a full one.
+			next := end
+			if next < len(data) && data[next] == '\r' {
+				next++
+			}
+			if end-lineStart < minHexRunLen || next >= len(data) || data[next] != '\n' ||
+				next+1 >= len(data) || !isHexDigit(data[next+1]) {
+				break
+			}
+			end = next + 1
+		}
+		i = end
+
+		// A run that follows a word may have picked up its last letter.
+		if len(digits)%2 == 1 {
+			digits = digits[1:]
+			start++
+		}
+		if len(digits) < minHexRunLen {
+			continue
+		}
+
+		text := make([]byte, len(digits)/2)
+		for j := range text {
+			text[j] = unhex(digits[2*j])<<4 | unhex(digits[2*j+1])
+		}
+		if !isPrintableText(text) {
+			continue
+		}
+
+		out = append(out, data[last:start]...)
+		out = append(out, text...)
+		last = end
+		decoded = true
+	}
+	if !decoded {
+		return data, false
+	}
+	return append(out, data[last:]...), true
+}
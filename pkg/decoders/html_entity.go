@@ -0,0 +1,49 @@
+package decoders
+
+import (
+	"html"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// HTMLEntity decodes HTML character references, both named (&quot;) and
+// numeric (&#34; or &#x22;), as found in HTML and XML documents and in text
+// scraped from web pages.
+type HTMLEntity struct{}
+
+var _ Decoder = (*HTMLEntity)(nil)
+
+var htmlEntityPat = regexp.MustCompile(`&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[a-zA-Z][a-zA-Z0-9]{1,31});`)
+
+func (d *HTMLEntity) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_HTML_ENTITY
+}
+
+func (d *HTMLEntity) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || !htmlEntityPat.Match(chunk.Data) {
+		return nil
+	}
+
+	// Unescape each reference on its own, because html.UnescapeString also
+	// decodes legacy references without a trailing semicolon, which would turn
+	// query strings like "?a=1&notify=true" into "?a=1¬ify=true".
+	decoded := false
+	data := htmlEntityPat.ReplaceAllFunc(chunk.Data, func(ref []byte) []byte {
+		text := html.UnescapeString(string(ref))
+		// Unknown names either aren't decoded or, if they start with a
+		// legacy name like "&not", decode to more than one or two runes.
+		if text == string(ref) || utf8.RuneCountInString(text) > 2 {
+			return ref
+		}
+		decoded = true
+		return []byte(text)
+	})
+
+	if !decoded {
+		return nil
+	}
+	return newDecodableChunk(chunk, data, d.Type())
+}
@@ -0,0 +1,70 @@
+package decoders
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// QuotedPrintable decodes quoted-printable text (RFC 2045), the transfer
+// encoding of most email bodies, e.g. in .eml and .mbox files.
+//
+// Plain text is full of "=XX" sequences (think KEY=DEADBEEF), so a chunk is
+// only decoded if it declares the encoding in a Content-Transfer-Encoding
+// header or contains soft line breaks. Decoding is lenient: invalid escapes
+// are left as they are rather than failing the whole chunk, as
+// mime/quotedprintable does.
+type QuotedPrintable struct{}
+
+var _ Decoder = (*QuotedPrintable)(nil)
+
+var (
+	qpHeaderPat    = regexp.MustCompile(`(?i)content-transfer-encoding:\s*quoted-printable`)
+	qpSoftBreakPat = regexp.MustCompile(`=\r?\n`)
+)
+
+func (d *QuotedPrintable) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_QUOTED_PRINTABLE
+}
+
+func (d *QuotedPrintable) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || bytes.IndexByte(chunk.Data, '=') == -1 {
+		return nil
+	}
+	if !qpSoftBreakPat.Match(chunk.Data) && !qpHeaderPat.Match(chunk.Data) {
+		return nil
+	}
+
+	var (
+		data    = chunk.Data
+		out     = make([]byte, 0, len(data))
+		decoded = false
+	)
+	for i := 0; i < len(data); i++ {
+		if data[i] != '=' {
+			out = append(out, data[i])
+			continue
+		}
+		switch {
+		case i+1 < len(data) && data[i+1] == '\n':
+			// Soft line break.
+			i++
+		case i+2 < len(data) && data[i+1] == '\r' && data[i+2] == '\n':
+			i += 2
+		case i+2 < len(data) && isHexDigit(data[i+1]) && isHexDigit(data[i+2]):
+			out = append(out, unhex(data[i+1])<<4|unhex(data[i+2]))
+			i += 2
+		default:
+			out = append(out, data[i])
+			continue
+		}
+		decoded = true
+	}
+
+	if !decoded {
+		return nil
+	}
+	return newDecodableChunk(chunk, out, d.Type())
+}
@@ -0,0 +1,47 @@
+package decoders
+
+import (
+	"bytes"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// URLEncoded decodes percent-encoded text, as found in URLs and query strings
+// written to logs. Unlike url.QueryUnescape, '+' is not decoded as a space,
+// because it is far more common in secrets (e.g. base64) than as an encoded
+// space, and invalid escapes are left as they are instead of failing the whole
+// chunk.
+type URLEncoded struct{}
+
+var _ Decoder = (*URLEncoded)(nil)
+
+func (d *URLEncoded) Type() detectorspb.DecoderType {
+	return detectorspb.DecoderType_URL_ENCODED
+}
+
+func (d *URLEncoded) FromChunk(chunk *sources.Chunk) *DecodableChunk {
+	if chunk == nil || bytes.IndexByte(chunk.Data, '%') == -1 {
+		return nil
+	}
+
+	var (
+		data    = chunk.Data
+		out     = make([]byte, 0, len(data))
+		decoded = false
+	)
+	for i := 0; i < len(data); i++ {
+		if data[i] == '%' && i+2 < len(data) && isHexDigit(data[i+1]) && isHexDigit(data[i+2]) {
+			out = append(out, unhex(data[i+1])<<4|unhex(data[i+2]))
+			i += 2
+			decoded = true
+			continue
+		}
+		out = append(out, data[i])
+	}
+
+	if !decoded {
+		return nil
+	}
+	return newDecodableChunk(chunk, out, d.Type())
+}
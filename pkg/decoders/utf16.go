@@ -20,13 +20,11 @@ func (d *UTF16) FromChunk(chunk *sources.Chunk) *DecodableChunk {
 		return nil
 	}
 
-	decodableChunk := &DecodableChunk{Chunk: chunk, DecoderType: d.Type()}
 	if utf16Data, err := utf16ToUTF8(chunk.Data); err == nil {
 		if len(utf16Data) == 0 {
 			return nil
 		}
-		chunk.Data = utf16Data
-		return decodableChunk
+		return newDecodableChunk(chunk, utf16Data, d.Type())
 	}
 
 	return nil
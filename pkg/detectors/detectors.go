@@ -209,6 +209,30 @@ type ResultWithMetadata struct {
 	DetectorDescription string
 	// DecoderType is the type of decoder that was used to generate this result's data.
 	DecoderType detectorspb.DecoderType
+	// DecoderChain lists every decoder that was applied to the chunk's data, in
+	// order, when the result was found in nested encodings. Its last element
+	// is DecoderType.
+	DecoderChain []detectorspb.DecoderType
+	// Analysis summarizes the permissions of a verified credential. It is only
+	// set when the engine analyzes verified results.
+	Analysis *AnalysisSummary
+}
+
+// AnalysisSummary is the outcome of running a credential's analyzer.
+type AnalysisSummary struct {
+	// AnalyzerType is the name of the analyzer that ran.
+	AnalyzerType string
+	// Permissions lists what the credential can do, per resource.
+	Permissions []ResourcePermissions `json:",omitempty"`
+	// Error is set if the analysis failed.
+	Error string `json:",omitempty"`
+}
+
+// ResourcePermissions lists the permissions a credential has on a resource.
+type ResourcePermissions struct {
+	Resource     string
+	ResourceType string
+	Permissions  []string
 }
 
 // CopyMetadata returns a detector result with included metadata from the source chunk.
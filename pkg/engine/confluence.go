@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"fmt"
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/confluence"
+)
+
+// ScanConfluence scans Confluence pages, page history and attachments.
+func (e *Engine) ScanConfluence(ctx context.Context, c sources.ConfluenceConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.Confluence{
+		Endpoint:              c.Endpoint,
+		Spaces:                c.Spaces,
+		IgnoreSpaces:          c.IgnoreSpaces,
+		IncludeAttachments:    c.IncludeAttachments,
+		SkipHistory:           c.SkipHistory,
+		InsecureSkipVerifyTls: c.InsecureSkipVerifyTLS,
+	}
+
+	switch c.SpacesScope {
+	case "", "all":
+		connection.SpacesScope = sourcespb.Confluence_ALL
+	case "global":
+		connection.SpacesScope = sourcespb.Confluence_GLOBAL
+	case "personal":
+		connection.SpacesScope = sourcespb.Confluence_PERSONAL
+	default:
+		return sources.JobProgressRef{}, fmt.Errorf("invalid spaces scope %q", c.SpacesScope)
+	}
+
+	switch {
+	case c.Username != "" && c.Password != "":
+		connection.Credential = &sourcespb.Confluence_BasicAuth{
+			BasicAuth: &credentialspb.BasicAuth{
+				Username: c.Username,
+				Password: c.Password,
+			},
+		}
+	case c.Token != "":
+		connection.Credential = &sourcespb.Confluence_Token{
+			Token: c.Token,
+		}
+	default:
+		connection.Credential = &sourcespb.Confluence_Unauthenticated{
+			Unauthenticated: &credentialspb.Unauthenticated{},
+		}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal confluence connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - confluence"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, confluence.SourceType)
+
+	confluenceSource := &confluence.Source{}
+	if err := confluenceSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, confluenceSource, &conn)
+}
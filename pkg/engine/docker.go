@@ -42,5 +42,5 @@ func (e *Engine) ScanDocker(ctx context.Context, c sources.DockerConfig) (source
 	if err := dockerSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
 	}
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, dockerSource)
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, dockerSource, &conn)
 }
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -18,6 +19,7 @@ import (
 	lru "github.com/hashicorp/golang-lru/v2"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/baseline"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -36,6 +38,16 @@ import (
 
 var detectionTimeout = detectors.DefaultResponseTimeout
 
+// DefaultMaxDecodeDepth is the number of decoders that can be applied on top
+// of each other when Config.MaxDecodeDepth isn't set. Two levels keep finding
+// secrets in base64 encoded UTF-16, such as PowerShell -EncodedCommand
+// payloads, without re-running every decoder on deeply nested data.
+const DefaultMaxDecodeDepth = 2
+
+// DefaultAnalyzeTimeout is how long the analysis of a verified result may
+// take when Config.AnalyzeTimeout isn't set.
+const DefaultAnalyzeTimeout = time.Minute
+
 var errOverlap = errors.New(
 	"More than one detector has found this result. For your safety, verification has been disabled." +
 		"You can override this behavior by using the --allow-verification-overlap flag.",
@@ -108,6 +120,11 @@ type Config struct {
 	CustomVerifiersOnly           bool
 	VerifierEndpoints             map[string]string
 
+	// MaxDecodeDepth is how many decoders can be applied on top of each other,
+	// e.g. 2 to find secrets that are base64 encoded twice. A depth of 1 runs
+	// each decoder once over the raw chunk. Defaults to DefaultMaxDecodeDepth.
+	MaxDecodeDepth int
+
 	// Verify determines whether the scanner will verify candidate secrets.
 	Verify bool
 
@@ -153,6 +170,21 @@ type Config struct {
 
 	VerificationResultCache  verificationcache.ResultCache
 	VerificationCacheMetrics verificationcache.MetricsReporter
+
+	// Baseline contains previously accepted findings. Results found in the
+	// baseline are not reported and do not count towards found results.
+	Baseline *baseline.Baseline
+	// BaselineRecorder, if set, records every result that passes the results
+	// filter so that a new baseline can be written after the scan.
+	BaselineRecorder *baseline.Baseline
+
+	// AnalyzeResult, if set, is run on every verified result that carries
+	// AnalysisInfo and the permission summary it returns is attached to the
+	// result.
+	AnalyzeResult func(context.Context, detectors.Result) *detectors.AnalysisSummary
+	// AnalyzeTimeout limits how long AnalyzeResult may run for a single
+	// result. Defaults to DefaultAnalyzeTimeout.
+	AnalyzeTimeout time.Duration
 }
 
 // Engine represents the core scanning engine responsible for detecting secrets in input data.
@@ -163,6 +195,7 @@ type Engine struct {
 	// CLI flags.
 	concurrency       int
 	decoders          []decoders.Decoder
+	maxDecodeDepth    int
 	detectors         []detectors.Detector
 	verificationCache *verificationcache.VerificationCache
 	// Any detectors configured to override sources' verification flags
@@ -189,7 +222,7 @@ type Engine struct {
 
 	// Engine synchronization primitives.
 	sourceManager                 *sources.SourceManager
-	results                       chan detectors.ResultWithMetadata
+	results                       chan notifiableResult
 	detectableChunksChan          chan detectableChunk
 	verificationOverlapChunksChan chan verificationOverlapChunk
 	workersWg                     sync.WaitGroup
@@ -212,6 +245,23 @@ type Engine struct {
 	// verify determines whether the scanner will attempt to verify candidate secrets.
 	verify bool
 
+	// baseline suppresses results that were previously accepted.
+	baseline *baseline.Baseline
+	// baselineRecorder collects results for writing a new baseline.
+	baselineRecorder *baseline.Baseline
+
+	// analyzeResult, if set, is used to analyze verified results before they
+	// are dispatched. analysisCache avoids analyzing the same credential
+	// more than once.
+	analyzeResult  func(context.Context, detectors.Result) *detectors.AnalysisSummary
+	analyzeTimeout time.Duration
+	analysisCache  *lru.Cache[string, *detectors.AnalysisSummary]
+
+	// finishHooks are run by Finish once every chunk has been scanned and
+	// every result has been notified.
+	finishHooksMu sync.Mutex
+	finishHooks   []func(context.Context)
+
 	// Note: bad hack only used for testing.
 	verificationOverlapTracker *verificationOverlapTracker
 
@@ -230,6 +280,7 @@ func NewEngine(ctx context.Context, cfg *Config) (*Engine, error) {
 	engine := &Engine{
 		concurrency:                         cfg.Concurrency,
 		decoders:                            cfg.Decoders,
+		maxDecodeDepth:                      cfg.MaxDecodeDepth,
 		detectors:                           cfg.Detectors,
 		verificationCache:                   verificationCache,
 		dispatcher:                          cfg.Dispatcher,
@@ -245,6 +296,10 @@ func NewEngine(ctx context.Context, cfg *Config) (*Engine, error) {
 		detectorWorkerMultiplier:            cfg.DetectorWorkerMultiplier,
 		notificationWorkerMultiplier:        cfg.NotificationWorkerMultiplier,
 		verificationOverlapWorkerMultiplier: cfg.VerificationOverlapWorkerMultiplier,
+		baseline:                            cfg.Baseline,
+		baselineRecorder:                    cfg.BaselineRecorder,
+		analyzeResult:                       cfg.AnalyzeResult,
+		analyzeTimeout:                      cfg.AnalyzeTimeout,
 	}
 	if engine.sourceManager == nil {
 		return nil, fmt.Errorf("source manager is required")
@@ -358,6 +413,12 @@ func (e *Engine) setDefaults(ctx context.Context) {
 	if len(e.decoders) == 0 {
 		e.decoders = decoders.DefaultDecoders()
 	}
+	if e.maxDecodeDepth < 1 {
+		e.maxDecodeDepth = DefaultMaxDecodeDepth
+	}
+	if e.analyzeTimeout <= 0 {
+		e.analyzeTimeout = DefaultAnalyzeTimeout
+	}
 
 	// Only use the default detectors if none are provided.
 	if len(e.detectors) == 0 {
@@ -517,8 +578,15 @@ func (e *Engine) initialize(ctx context.Context) error {
 	e.verificationOverlapChunksChan = make(
 		chan verificationOverlapChunk, defaultChannelBuffer*verificationOverlapChunksChanMultiplier,
 	)
-	e.results = make(chan detectors.ResultWithMetadata, defaultChannelBuffer*resultsChanMultiplier)
+	e.results = make(chan notifiableResult, defaultChannelBuffer*resultsChanMultiplier)
 	e.dedupeCache = cache
+	if e.analyzeResult != nil {
+		analysisCache, err := lru.New[string, *detectors.AnalysisSummary](cacheSize)
+		if err != nil {
+			return fmt.Errorf("failed to initialize analysis cache: %w", err)
+		}
+		e.analysisCache = analysisCache
+	}
 	ctx.Logger().V(4).Info("engine initialized")
 
 	// Configure the EntireChunkSpanCalculator if the engine is set to scan the entire chunk.
@@ -748,16 +816,30 @@ func (e *Engine) Finish(ctx context.Context) error {
 	close(e.results)    // Detector workers are done, close the results channel and call it a day.
 	e.WgNotifier.Wait() // Wait for the notifier workers to finish notifying results.
 
+	e.finishHooksMu.Lock()
+	for _, hook := range e.finishHooks {
+		hook(ctx)
+	}
+	e.finishHooksMu.Unlock()
+
 	e.metrics.ScanDuration = time.Since(e.metrics.scanStartTime)
 
 	return err
 }
 
+// onFinish registers hook to be run by Finish after every chunk has been
+// scanned and every result has been notified.
+func (e *Engine) onFinish(hook func(context.Context)) {
+	e.finishHooksMu.Lock()
+	defer e.finishHooksMu.Unlock()
+	e.finishHooks = append(e.finishHooks, hook)
+}
+
 func (e *Engine) ChunksChan() <-chan *sources.Chunk {
 	return e.sourceManager.Chunks()
 }
 
-func (e *Engine) ResultsChan() chan detectors.ResultWithMetadata {
+func (e *Engine) ResultsChan() chan notifiableResult {
 	return e.results
 }
 
@@ -774,6 +856,9 @@ type detectableChunk struct {
 	chunk    sources.Chunk
 	decoder  detectorspb.DecoderType
 	wgDoneFn func()
+	// decoderChain lists every decoder applied to the chunk, ending with decoder.
+	decoderChain []detectorspb.DecoderType
+	tracker      *chunkTracker
 }
 
 // verificationOverlapChunk is a decoded chunk that has multiple detectors that match it.
@@ -782,8 +867,47 @@ type detectableChunk struct {
 type verificationOverlapChunk struct {
 	chunk                       sources.Chunk
 	decoder                     detectorspb.DecoderType
+	decoderChain                []detectorspb.DecoderType
 	detectors                   []*ahocorasick.DetectorMatch
 	verificationOverlapWgDoneFn func()
+	tracker                     *chunkTracker
+}
+
+// notifiableResult is a result waiting to be notified, along with the tracker
+// of the chunk it was found in.
+type notifiableResult struct {
+	detectors.ResultWithMetadata
+	tracker *chunkTracker
+}
+
+// chunkTracker tells the source manager that a chunk has been scanned once
+// every detection and notification derived from it is done. Each piece of
+// pending work holds a reference to the tracker and releases it when it's
+// done. A nil *chunkTracker is valid and does nothing.
+type chunkTracker struct {
+	chunk         *sources.Chunk
+	sourceManager *sources.SourceManager
+	pending       atomic.Int64
+}
+
+// newChunkTracker returns a tracker for chunk holding one reference, which
+// the caller must release.
+func (e *Engine) newChunkTracker(chunk *sources.Chunk) *chunkTracker {
+	t := &chunkTracker{chunk: chunk, sourceManager: e.sourceManager}
+	t.pending.Store(1)
+	return t
+}
+
+func (t *chunkTracker) acquire() {
+	if t != nil {
+		t.pending.Add(1)
+	}
+}
+
+func (t *chunkTracker) release() {
+	if t != nil && t.pending.Add(-1) == 0 {
+		t.sourceManager.ChunkScanned(t.chunk)
+	}
 }
 
 func (e *Engine) scannerWorker(ctx context.Context) {
@@ -792,41 +916,9 @@ func (e *Engine) scannerWorker(ctx context.Context) {
 
 	for chunk := range e.ChunksChan() {
 		startTime := time.Now()
-		sourceVerify := chunk.Verify
-		for _, decoder := range e.decoders {
-			decodeStart := time.Now()
-			decoded := decoder.FromChunk(chunk)
-			decodeTime := time.Since(decodeStart).Microseconds()
-			decodeLatency.WithLabelValues(decoder.Type().String(), chunk.SourceName).Observe(float64(decodeTime))
-
-			if decoded == nil {
-				// This means that the decoder didn't understand this chunk and isn't applicable to it.
-				continue
-			}
-
-			matchingDetectors := e.AhoCorasickCore.FindDetectorMatches(decoded.Chunk.Data)
-			if len(matchingDetectors) > 1 && !e.verificationOverlap {
-				wgVerificationOverlap.Add(1)
-				e.verificationOverlapChunksChan <- verificationOverlapChunk{
-					chunk:                       *decoded.Chunk,
-					detectors:                   matchingDetectors,
-					decoder:                     decoded.DecoderType,
-					verificationOverlapWgDoneFn: wgVerificationOverlap.Done,
-				}
-				continue
-			}
-
-			for _, detector := range matchingDetectors {
-				decoded.Chunk.Verify = e.shouldVerifyChunk(sourceVerify, detector, e.detectorVerificationOverrides)
-				wgDetect.Add(1)
-				e.detectableChunksChan <- detectableChunk{
-					chunk:    *decoded.Chunk,
-					detector: detector,
-					decoder:  decoded.DecoderType,
-					wgDoneFn: wgDetect.Done,
-				}
-			}
-		}
+		tracker := e.newChunkTracker(chunk)
+		e.decodeChunk(chunk, chunk.Verify, nil, tracker, &wgDetect, &wgVerificationOverlap)
+		tracker.release()
 
 		dataSize := float64(len(chunk.Data))
 
@@ -850,6 +942,80 @@ func (e *Engine) scannerWorker(ctx context.Context) {
 	ctx.Logger().V(4).Info("finished scanning chunks")
 }
 
+// decodeChunk runs every decoder over chunk and sends the decoded data to the
+// detectors whose keywords it contains. Data that a decoder changed is decoded
+// again, up to maxDecodeDepth levels deep, so that nested encodings such as
+// base64 inside a JSON string with \u escapes are found too. The keyword
+// prefilter is applied at every level, so detectors only run on decoded data
+// that contains their keywords. chain lists the decoders that produced chunk
+// and is empty for the raw chunk. tracker tracks the raw chunk.
+func (e *Engine) decodeChunk(
+	chunk *sources.Chunk,
+	sourceVerify bool,
+	chain []detectorspb.DecoderType,
+	tracker *chunkTracker,
+	wgDetect, wgVerificationOverlap *sync.WaitGroup,
+) {
+	for _, decoder := range e.decoders {
+		// The plain decoder returns the data it's given, which has already
+		// been scanned at the previous level.
+		if len(chain) > 0 && decoder.Type() == detectorspb.DecoderType_PLAIN {
+			continue
+		}
+
+		decodeStart := time.Now()
+		decoded := decoder.FromChunk(chunk)
+		decodeTime := time.Since(decodeStart).Microseconds()
+		decodeLatency.WithLabelValues(decoder.Type().String(), chunk.SourceName).Observe(float64(decodeTime))
+
+		if decoded == nil {
+			// This means that the decoder didn't understand this chunk and isn't applicable to it.
+			continue
+		}
+		if len(chain) > 0 && bytes.Equal(decoded.Chunk.Data, chunk.Data) {
+			continue
+		}
+		decoderChain := append(slices.Clip(chain), decoded.DecoderType)
+
+		matchingDetectors := e.AhoCorasickCore.FindDetectorMatches(decoded.Chunk.Data)
+		if len(matchingDetectors) > 1 && !e.verificationOverlap {
+			wgVerificationOverlap.Add(1)
+			tracker.acquire()
+			e.verificationOverlapChunksChan <- verificationOverlapChunk{
+				chunk:                       *decoded.Chunk,
+				detectors:                   matchingDetectors,
+				decoder:                     decoded.DecoderType,
+				decoderChain:                decoderChain,
+				verificationOverlapWgDoneFn: wgVerificationOverlap.Done,
+				tracker:                     tracker,
+			}
+		} else {
+			for _, detector := range matchingDetectors {
+				decoded.Chunk.Verify = e.shouldVerifyChunk(sourceVerify, detector, e.detectorVerificationOverrides)
+				wgDetect.Add(1)
+				tracker.acquire()
+				e.detectableChunksChan <- detectableChunk{
+					chunk:        *decoded.Chunk,
+					detector:     detector,
+					decoder:      decoded.DecoderType,
+					decoderChain: decoderChain,
+					wgDoneFn:     wgDetect.Done,
+					tracker:      tracker,
+				}
+			}
+		}
+
+		// Decoding the plain chunk again would only repeat this level.
+		if decoded.DecoderType == detectorspb.DecoderType_PLAIN || len(decoderChain) >= e.maxDecodeDepth {
+			continue
+		}
+		// Decoders may modify the chunk they're given, so the next level
+		// works on its own copy.
+		next := *decoded.Chunk
+		e.decodeChunk(&next, sourceVerify, decoderChain, tracker, wgDetect, wgVerificationOverlap)
+	}
+}
+
 func (e *Engine) shouldVerifyChunk(
 	sourceVerify bool,
 	detector detectors.Detector,
@@ -999,10 +1165,12 @@ func (e *Engine) verificationOverlapWorker(ctx context.Context) {
 						e.processResult(
 							ctx,
 							detectableChunk{
-								chunk:    chunk.chunk,
-								detector: detector,
-								decoder:  chunk.decoder,
-								wgDoneFn: wgDetect.Done,
+								chunk:        chunk.chunk,
+								detector:     detector,
+								decoder:      chunk.decoder,
+								decoderChain: chunk.decoderChain,
+								wgDoneFn:     wgDetect.Done,
+								tracker:      chunk.tracker,
 							},
 							res,
 							isFalsePositive,
@@ -1020,12 +1188,15 @@ func (e *Engine) verificationOverlapWorker(ctx context.Context) {
 
 		for _, detector := range detectorKeysWithResults {
 			wgDetect.Add(1)
+			chunk.tracker.acquire()
 			chunk.chunk.Verify = e.shouldVerifyChunk(chunk.chunk.Verify, detector, e.detectorVerificationOverrides)
 			e.detectableChunksChan <- detectableChunk{
-				chunk:    chunk.chunk,
-				detector: detector,
-				decoder:  chunk.decoder,
-				wgDoneFn: wgDetect.Done,
+				chunk:        chunk.chunk,
+				detector:     detector,
+				decoder:      chunk.decoder,
+				decoderChain: chunk.decoderChain,
+				wgDoneFn:     wgDetect.Done,
+				tracker:      chunk.tracker,
 			}
 		}
 
@@ -1038,6 +1209,7 @@ func (e *Engine) verificationOverlapWorker(ctx context.Context) {
 		}
 
 		chunk.verificationOverlapWgDoneFn()
+		chunk.tracker.release()
 	}
 
 	wgDetect.Wait()
@@ -1140,6 +1312,7 @@ func (e *Engine) detectChunk(ctx context.Context, data detectableChunk) {
 	ctx.Logger().V(5).Info("Finished detecting chunk")
 
 	data.wgDoneFn()
+	data.tracker.release()
 }
 
 func (e *Engine) filterResults(
@@ -1195,6 +1368,7 @@ func (e *Engine) processResult(
 
 	secret := detectors.CopyMetadata(&data.chunk, res)
 	secret.DecoderType = data.decoder
+	secret.DecoderChain = data.decoderChain
 	secret.DetectorDescription = data.detector.Detector.Description()
 
 	if !res.Verified && res.Raw != nil {
@@ -1202,54 +1376,112 @@ func (e *Engine) processResult(
 		secret.IsWordlistFalsePositive = isFp
 	}
 
-	e.results <- secret
+	data.tracker.acquire()
+	e.results <- notifiableResult{ResultWithMetadata: secret, tracker: data.tracker}
 }
 
 func (e *Engine) notifierWorker(ctx context.Context) {
 	for result := range e.ResultsChan() {
-		startTime := time.Now()
-		// Filter unwanted results, based on `--results`.
-		if !result.Verified {
-			if result.VerificationError() != nil {
-				if !e.notifyUnknownResults {
-					// Skip results with verification errors.
-					continue
-				}
-			} else if !e.notifyUnverifiedResults {
-				// Skip unverified results.
-				continue
+		e.notifyResult(ctx, result.ResultWithMetadata)
+		result.tracker.release()
+	}
+}
+
+func (e *Engine) notifyResult(ctx context.Context, result detectors.ResultWithMetadata) {
+	startTime := time.Now()
+	// Filter unwanted results, based on `--results`.
+	if !result.Verified {
+		if result.VerificationError() != nil {
+			if !e.notifyUnknownResults {
+				// Skip results with verification errors.
+				return
 			}
-		} else if !e.notifyVerifiedResults {
-			// Skip verified results.
-			// TODO: Is this a legitimate use case?
-			continue
-		}
-		atomic.AddUint32(&e.numFoundResults, 1)
-
-		// Dedupe results by comparing the detector type, raw result, and source metadata.
-		// We want to avoid duplicate results with different decoder types, but we also
-		// want to include duplicate results with the same decoder type.
-		// Duplicate results with the same decoder type SHOULD have their own entry in the
-		// results list, this would happen if the same secret is found multiple times.
-		// Note: If the source type is postman, we dedupe the results regardless of decoder type.
-		key := fmt.Sprintf("%s%s%s%+v", result.DetectorType.String(), result.Raw, result.RawV2, result.SourceMetadata)
-		if val, ok := e.dedupeCache.Get(key); ok && (val != result.DecoderType ||
-			result.SourceType == sourcespb.SourceType_SOURCE_TYPE_POSTMAN) {
-			continue
+		} else if !e.notifyUnverifiedResults {
+			// Skip unverified results.
+			return
 		}
-		e.dedupeCache.Add(key, result.DecoderType)
+	} else if !e.notifyVerifiedResults {
+		// Skip verified results.
+		// TODO: Is this a legitimate use case?
+		return
+	}
 
-		if result.Verified {
-			atomic.AddUint64(&e.metrics.VerifiedSecretsFound, 1)
-		} else {
-			atomic.AddUint64(&e.metrics.UnverifiedSecretsFound, 1)
+	if e.baselineRecorder != nil {
+		if err := e.baselineRecorder.Add(&result); err != nil {
+			ctx.Logger().Error(err, "error recording result in baseline")
 		}
+	}
+	// Skip results that were already accepted in the baseline.
+	if e.baseline != nil && e.baseline.Contains(&result) {
+		return
+	}
+	atomic.AddUint32(&e.numFoundResults, 1)
+
+	// Dedupe results by comparing the detector type, raw result, and source metadata.
+	// We want to avoid duplicate results with different decoder types, but we also
+	// want to include duplicate results with the same decoder type.
+	// Duplicate results with the same decoder type SHOULD have their own entry in the
+	// results list, this would happen if the same secret is found multiple times.
+	// Note: If the source type is postman, we dedupe the results regardless of decoder type.
+	key := fmt.Sprintf("%s%s%s%+v", result.DetectorType.String(), result.Raw, result.RawV2, result.SourceMetadata)
+	if val, ok := e.dedupeCache.Get(key); ok && (val != result.DecoderType ||
+		result.SourceType == sourcespb.SourceType_SOURCE_TYPE_POSTMAN) {
+		return
+	}
+	e.dedupeCache.Add(key, result.DecoderType)
 
-		if err := e.dispatcher.Dispatch(ctx, result); err != nil {
-			ctx.Logger().Error(err, "error notifying result")
-		}
+	if result.Verified {
+		atomic.AddUint64(&e.metrics.VerifiedSecretsFound, 1)
+	} else {
+		atomic.AddUint64(&e.metrics.UnverifiedSecretsFound, 1)
+	}
+
+	if result.Verified && len(result.AnalysisInfo) > 0 && e.analyzeResult != nil {
+		result.Analysis = e.analyze(ctx, result.Result)
+	}
+
+	if err := e.dispatcher.Dispatch(ctx, result); err != nil {
+		ctx.Logger().Error(err, "error notifying result")
+	}
+
+	chunksNotifiedLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+}
+
+// analyze returns the permission summary for a verified result, running its
+// analyzer only the first time the credential is seen.
+func (e *Engine) analyze(ctx context.Context, result detectors.Result) *detectors.AnalysisSummary {
+	key := fmt.Sprintf("%s%s%s", result.DetectorType, result.Raw, result.RawV2)
+	if summary, ok := e.analysisCache.Get(key); ok {
+		return summary
+	}
+	summary := e.analyzeWithTimeout(ctx, result)
+	if summary != nil && summary.Error != "" {
+		ctx.Logger().V(2).Info("error analyzing result", "detector", result.DetectorType.String(), "error", summary.Error)
+	}
+	e.analysisCache.Add(key, summary)
+	return summary
+}
 
-		chunksNotifiedLatency.Observe(float64(time.Since(startTime).Milliseconds()))
+// analyzeWithTimeout runs analyzeResult, giving up after analyzeTimeout.
+// Analyzers don't all honor their context, so one that runs past the timeout
+// is left to finish in the background rather than holding up the notifier.
+func (e *Engine) analyzeWithTimeout(ctx context.Context, result detectors.Result) *detectors.AnalysisSummary {
+	ctx, cancel := context.WithTimeout(ctx, e.analyzeTimeout)
+	defer cancel()
+
+	done := make(chan *detectors.AnalysisSummary, 1)
+	go func() {
+		var summary *detectors.AnalysisSummary
+		defer func() { done <- summary }()
+		defer common.Recover(ctx)
+		summary = e.analyzeResult(ctx, result)
+	}()
+	select {
+	case summary := <-done:
+		return summary
+	case <-ctx.Done():
+		ctx.Logger().Info("analysis of verified result timed out", "detector", result.DetectorType.String(), "timeout", e.analyzeTimeout.String())
+		return &detectors.AnalysisSummary{Error: fmt.Sprintf("analysis timed out after %s", e.analyzeTimeout)}
 	}
 }
 
@@ -2,17 +2,25 @@ package engine
 
 import (
 	aCtx "context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/trufflesecurity/trufflehog/v3/pkg/baseline"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/config"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/custom_detectors"
@@ -1318,3 +1326,388 @@ def test_something():
 		})
 	}
 }
+
+func TestEngineBaseline(t *testing.T) {
+	absPath, err := filepath.Abs("./testdata/secrets.txt")
+	assert.NoError(t, err)
+
+	scan := func(t *testing.T, conf Config) *Engine {
+		t.Helper()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		const defaultOutputBufferSize = 64
+		conf.Concurrency = 1
+		conf.Decoders = decoders.DefaultDecoders()
+		conf.Detectors = defaults.DefaultDetectors()
+		conf.SourceManager = sources.NewManager(
+			sources.WithSourceUnits(),
+			sources.WithBufferedOutput(defaultOutputBufferSize),
+		)
+		conf.Dispatcher = NewPrinterDispatcher(new(discardPrinter))
+
+		eng, err := NewEngine(ctx, &conf)
+		assert.NoError(t, err)
+		eng.Start(ctx)
+
+		_, err = eng.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{absPath}})
+		assert.NoError(t, err)
+		assert.NoError(t, eng.Finish(ctx))
+		return eng
+	}
+
+	recorder := baseline.New()
+	eng := scan(t, Config{BaselineRecorder: recorder})
+	assert.True(t, eng.HasFoundResults())
+	assert.Equal(t, 2, recorder.Len())
+
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+	assert.NoError(t, recorder.WriteFile(baselinePath))
+	known, err := baseline.Load(baselinePath)
+	assert.NoError(t, err)
+
+	eng = scan(t, Config{Baseline: known})
+	assert.False(t, eng.HasFoundResults())
+	assert.Equal(t, uint64(0), eng.GetMetrics().UnverifiedSecretsFound)
+}
+
+const nestedDetectorKeyword = "qzxk"
+
+// nestedDetector reports every "qzxk_" token it's given.
+type nestedDetector struct{}
+
+var _ detectors.Detector = (*nestedDetector)(nil)
+
+var nestedSecretPat = regexp.MustCompile(nestedDetectorKeyword + `_[a-z0-9]{16}`)
+
+func (nestedDetector) FromData(_ aCtx.Context, _ bool, data []byte) ([]detectors.Result, error) {
+	var results []detectors.Result
+	for _, match := range nestedSecretPat.FindAll(data, -1) {
+		results = append(results, detectors.Result{DetectorType: detectorspb.DetectorType(-2), Raw: match})
+	}
+	return results, nil
+}
+
+func (nestedDetector) Keywords() []string             { return []string{nestedDetectorKeyword} }
+func (nestedDetector) Type() detectorspb.DetectorType { return detectorspb.DetectorType(-2) }
+func (nestedDetector) Description() string            { return "" }
+
+// resultCaptureDispatcher is a test dispatcher that keeps every result.
+type resultCaptureDispatcher struct {
+	mu      sync.Mutex
+	results []detectors.ResultWithMetadata
+}
+
+func (d *resultCaptureDispatcher) Dispatch(_ context.Context, result detectors.ResultWithMetadata) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.results = append(d.results, result)
+	return nil
+}
+
+func TestEngine_NestedDecoding(t *testing.T) {
+	const secret = "token = qzxk_q7m2x9k4p1v8r3t6"
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+	utf16le := func(s string) string {
+		var sb strings.Builder
+		for _, r := range s {
+			sb.WriteByte(byte(r))
+			sb.WriteByte(0)
+		}
+		return sb.String()
+	}
+	escape := func(s string) string {
+		var sb strings.Builder
+		for _, r := range s {
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		}
+		return sb.String()
+	}
+
+	tests := []struct {
+		name      string
+		data      string
+		depth     int
+		wantChain []detectorspb.DecoderType
+	}{
+		{
+			name:      "plain",
+			data:      secret,
+			wantChain: []detectorspb.DecoderType{detectorspb.DecoderType_PLAIN},
+		},
+		{
+			name:      "double base64",
+			data:      "config: " + b64(b64(secret)),
+			wantChain: []detectorspb.DecoderType{detectorspb.DecoderType_BASE64, detectorspb.DecoderType_BASE64},
+		},
+		{
+			name:      "base64 in a JSON string with unicode escapes",
+			data:      `{"config": "` + escape(b64(secret)) + `"}`,
+			wantChain: []detectorspb.DecoderType{detectorspb.DecoderType_ESCAPED_UNICODE, detectorspb.DecoderType_BASE64},
+		},
+		{
+			name:      "base64 encoded UTF-16",
+			data:      "powershell -EncodedCommand " + b64(utf16le(secret)),
+			wantChain: []detectorspb.DecoderType{detectorspb.DecoderType_BASE64, detectorspb.DecoderType_UTF16},
+		},
+		{
+			name:      "triple base64",
+			data:      "config: " + b64(b64(b64(secret))),
+			depth:     3,
+			wantChain: []detectorspb.DecoderType{detectorspb.DecoderType_BASE64, detectorspb.DecoderType_BASE64, detectorspb.DecoderType_BASE64},
+		},
+		{
+			name: "beyond the default depth",
+			data: "config: " + b64(b64(b64(secret))),
+		},
+		{
+			name:  "depth of one",
+			data:  "config: " + b64(b64(secret)),
+			depth: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			path := filepath.Join(t.TempDir(), "config.txt")
+			assert.NoError(t, os.WriteFile(path, []byte(tt.data), 0o644))
+
+			dispatcher := new(resultCaptureDispatcher)
+			conf := Config{
+				Concurrency:    1,
+				Decoders:       decoders.DefaultDecoders(),
+				Detectors:      []detectors.Detector{nestedDetector{}},
+				MaxDecodeDepth: tt.depth,
+				SourceManager: sources.NewManager(
+					sources.WithSourceUnits(),
+					sources.WithBufferedOutput(64),
+				),
+				Dispatcher: dispatcher,
+			}
+			eng, err := NewEngine(ctx, &conf)
+			assert.NoError(t, err)
+			eng.Start(ctx)
+
+			_, err = eng.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{path}})
+			assert.NoError(t, err)
+			assert.NoError(t, eng.Finish(ctx))
+
+			if tt.wantChain == nil {
+				assert.Empty(t, dispatcher.results)
+				return
+			}
+			if assert.Len(t, dispatcher.results, 1) {
+				result := dispatcher.results[0]
+				assert.Equal(t, "qzxk_q7m2x9k4p1v8r3t6", string(result.Raw))
+				assert.Equal(t, tt.wantChain, result.DecoderChain)
+				assert.Equal(t, tt.wantChain[len(tt.wantChain)-1], result.DecoderType)
+			}
+		})
+	}
+}
+
+// analysisDetector reports "qzxk_" tokens, verifying those that start with "v".
+type analysisDetector struct{ nestedDetector }
+
+func (analysisDetector) FromData(_ aCtx.Context, _ bool, data []byte) ([]detectors.Result, error) {
+	var results []detectors.Result
+	for _, match := range nestedSecretPat.FindAll(data, -1) {
+		results = append(results, detectors.Result{
+			DetectorType: detectorspb.DetectorType(-2),
+			Raw:          match,
+			Verified:     match[len(nestedDetectorKeyword)+1] == 'v',
+			AnalysisInfo: map[string]string{"key": string(match)},
+		})
+	}
+	return results, nil
+}
+
+func TestEngine_AnalyzeVerified(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	const verified, unverified = "qzxk_v7m2x9k4p1q8r3t6", "qzxk_q7m2x9k4p1v8r3t6"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte(verified+"\n"+unverified), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte(verified), 0o644))
+
+	dispatcher := new(resultCaptureDispatcher)
+	conf := Config{
+		Concurrency: 1,
+		Decoders:    decoders.DefaultDecoders(),
+		Detectors:   []detectors.Detector{analysisDetector{}},
+		SourceManager: sources.NewManager(
+			sources.WithSourceUnits(),
+			sources.WithBufferedOutput(64),
+		),
+		Dispatcher: dispatcher,
+	}
+	var calls atomic.Int32
+	conf.AnalyzeResult = func(_ context.Context, result detectors.Result) *detectors.AnalysisSummary {
+		calls.Add(1)
+		return &detectors.AnalysisSummary{
+			AnalyzerType: "Fake",
+			Permissions: []detectors.ResourcePermissions{
+				{Resource: result.AnalysisInfo["key"], Permissions: []string{"read"}},
+			},
+		}
+	}
+	eng, err := NewEngine(ctx, &conf)
+	assert.NoError(t, err)
+	eng.Start(ctx)
+
+	_, err = eng.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{dir}})
+	assert.NoError(t, err)
+	assert.NoError(t, eng.Finish(ctx))
+
+	assert.Len(t, dispatcher.results, 3)
+	for _, result := range dispatcher.results {
+		if !result.Verified {
+			assert.Nil(t, result.Analysis)
+			continue
+		}
+		if assert.NotNil(t, result.Analysis) {
+			assert.Equal(t, "Fake", result.Analysis.AnalyzerType)
+			assert.Equal(t, verified, result.Analysis.Permissions[0].Resource)
+		}
+	}
+	// The credential is analyzed once, however many times it's found.
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestEngine_AnalyzeVerifiedTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	const verified = "qzxk_v7m2x9k4p1q8r3t6"
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte(verified), 0o644))
+
+	dispatcher := new(resultCaptureDispatcher)
+	conf := Config{
+		Concurrency: 1,
+		Decoders:    decoders.DefaultDecoders(),
+		Detectors:   []detectors.Detector{analysisDetector{}},
+		SourceManager: sources.NewManager(
+			sources.WithSourceUnits(),
+			sources.WithBufferedOutput(64),
+		),
+		Dispatcher:     dispatcher,
+		AnalyzeTimeout: 50 * time.Millisecond,
+	}
+	// The analyzer ignores its context and never returns on its own.
+	release := make(chan struct{})
+	defer close(release)
+	conf.AnalyzeResult = func(context.Context, detectors.Result) *detectors.AnalysisSummary {
+		<-release
+		return nil
+	}
+	eng, err := NewEngine(ctx, &conf)
+	assert.NoError(t, err)
+	eng.Start(ctx)
+
+	_, err = eng.ScanFileSystem(ctx, sources.FilesystemConfig{Paths: []string{dir}})
+	assert.NoError(t, err)
+	assert.NoError(t, eng.Finish(ctx))
+
+	if assert.Len(t, dispatcher.results, 1) && assert.NotNil(t, dispatcher.results[0].Analysis) {
+		assert.Contains(t, dispatcher.results[0].Analysis.Error, "timed out")
+	}
+}
+
+// unitsSource has one unit with a secret and one unit that fails to chunk.
+type unitsSource struct{ sources.Progress }
+
+func (*unitsSource) Type() sourcespb.SourceType       { return sourcespb.SourceType_SOURCE_TYPE_FILESYSTEM }
+func (*unitsSource) SourceID() sources.SourceID       { return 0 }
+func (*unitsSource) JobID() sources.JobID             { return 0 }
+func (s *unitsSource) GetProgress() *sources.Progress { return &s.Progress }
+func (*unitsSource) Init(context.Context, string, sources.JobID, sources.SourceID, bool, *anypb.Any, int) error {
+	return nil
+}
+func (*unitsSource) Chunks(context.Context, chan *sources.Chunk, ...sources.ChunkingTarget) error {
+	return nil
+}
+
+func (*unitsSource) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	for _, id := range []string{"secret", "broken"} {
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{ID: id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*unitsSource) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	if id, _ := unit.SourceUnitID(); id == "broken" {
+		return fmt.Errorf("unit %s is broken", id)
+	}
+	return reporter.ChunkOk(ctx, sources.Chunk{Data: []byte("token = qzxk_q7m2x9k4p1v8r3t6")})
+}
+
+// blockingDispatcher signals dispatched for each result and waits for release.
+type blockingDispatcher struct {
+	dispatched chan struct{}
+	release    chan struct{}
+}
+
+func (d *blockingDispatcher) Dispatch(context.Context, detectors.ResultWithMetadata) error {
+	d.dispatched <- struct{}{}
+	<-d.release
+	return nil
+}
+
+func TestEngine_CheckpointWaitsForNotification(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	completedUnits := func() []string {
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		var file struct {
+			Jobs map[string]struct {
+				CompletedUnits []string `json:"completed_units"`
+			} `json:"jobs"`
+		}
+		assert.NoError(t, json.Unmarshal(data, &file))
+		var units []string
+		for _, job := range file.Jobs {
+			units = append(units, job.CompletedUnits...)
+		}
+		return units
+	}
+
+	checkpointer, err := sources.NewCheckpointer(path)
+	assert.NoError(t, err)
+	dispatcher := &blockingDispatcher{dispatched: make(chan struct{}), release: make(chan struct{})}
+	conf := Config{
+		Concurrency: 1,
+		Decoders:    decoders.DefaultDecoders(),
+		Detectors:   []detectors.Detector{nestedDetector{}},
+		SourceManager: sources.NewManager(
+			sources.WithSourceUnits(),
+			sources.WithBufferedOutput(64),
+			sources.WithCheckpointer(checkpointer, time.Hour),
+		),
+		Dispatcher: dispatcher,
+	}
+	eng, err := NewEngine(ctx, &conf)
+	assert.NoError(t, err)
+	eng.Start(ctx)
+
+	_, err = eng.sourceManager.EnumerateAndScan(ctx, "units", new(unitsSource))
+	assert.NoError(t, err)
+
+	// The unit's result is being notified, so the unit isn't complete yet.
+	<-dispatcher.dispatched
+	assert.NoError(t, checkpointer.Save())
+	assert.Empty(t, completedUnits())
+
+	close(dispatcher.release)
+	assert.Error(t, eng.Finish(ctx))
+	assert.Equal(t, []string{"unit:secret"}, completedUnits())
+}
@@ -18,6 +18,9 @@ func (e *Engine) ScanFileSystem(ctx context.Context, c sources.FilesystemConfig)
 		Paths:            c.Paths,
 		IncludePathsFile: c.IncludePathsFile,
 		ExcludePathsFile: c.ExcludePathsFile,
+		UseIgnoreFiles:   c.UseIgnoreFiles,
+		FollowSymlinks:   c.FollowSymlinks,
+		ConfineSymlinks:  c.ConfineSymlinks,
 	}
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -33,5 +36,5 @@ func (e *Engine) ScanFileSystem(ctx context.Context, c sources.FilesystemConfig)
 	if err := fileSystemSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
 	}
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, fileSystemSource)
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, fileSystemSource, &conn)
 }
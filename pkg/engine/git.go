@@ -35,9 +35,60 @@ func (e *Engine) ScanGit(ctx context.Context, c sources.GitConfig) (sources.JobP
 	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, git.SourceType)
 
 	gitSource := &git.Source{}
+	if len(c.Revisions) > 0 {
+		gitSource.WithRevisions(c.Revisions)
+	}
+	if len(c.Ranges) > 0 {
+		gitSource.WithRanges(c.Ranges)
+	}
+	if c.StagedOnly {
+		gitSource.WithStagedOnly()
+	}
+	var objectClasses []git.ObjectClass
+	if c.IncludeTags {
+		objectClasses = append(objectClasses, git.ObjectClassTag)
+	}
+	if c.IncludeStashes {
+		objectClasses = append(objectClasses, git.ObjectClassStash)
+	}
+	if c.IncludeReflog {
+		objectClasses = append(objectClasses, git.ObjectClassReflog)
+	}
+	if c.IncludeDangling {
+		objectClasses = append(objectClasses, git.ObjectClassDangling)
+	}
+	if len(objectClasses) > 0 {
+		gitSource.WithObjectClasses(objectClasses)
+	}
+	if c.StateFile != "" {
+		state, err := git.LoadScanState(c.StateFile)
+		if err != nil {
+			return sources.JobProgressRef{}, err
+		}
+		gitSource.WithScanState(state)
+	}
 	if err := gitSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
 	}
 
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, gitSource)
+	ref, err := e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, gitSource, &conn)
+	if err != nil || c.StateFile == "" {
+		return ref, err
+	}
+	// The scan state may only move forward once the new commits have been
+	// fully scanned, so it is saved after the engine has finished.
+	e.onFinish(func(ctx context.Context) {
+		if ctx.Err() != nil {
+			ctx.Logger().Info("scan was interrupted, not updating the scan state", "state_file", c.StateFile)
+			return
+		}
+		if errs := ref.Snapshot().Errors; len(errs) > 0 {
+			ctx.Logger().Info("scan had errors, not updating the scan state", "state_file", c.StateFile, "errors", len(errs))
+			return
+		}
+		if err := gitSource.SaveScanState(); err != nil {
+			ctx.Logger().Error(err, "error saving scan state", "state_file", c.StateFile)
+		}
+	})
+	return ref, nil
 }
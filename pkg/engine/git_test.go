@@ -2,10 +2,14 @@ package engine
 
 import (
 	"os"
+	"os/exec"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/decoders"
@@ -161,3 +165,55 @@ func BenchmarkGitEngine(b *testing.B) {
 	}
 	assert.Nil(b, e.Finish(ctx))
 }
+
+func TestGitEngineSavesScanStateAfterFinish(t *testing.T) {
+	dir := t.TempDir()
+	gitCmd := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	gitCmd("init", "-q", "-b", "main")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "file.txt"), []byte("hello\n"), 0o644))
+	gitCmd("add", "file.txt")
+	gitCmd("commit", "-q", "-m", "first")
+	tip := gitCmd("rev-parse", "HEAD")
+
+	uri := "file://" + dir
+	statePath := filepath.Join(t.TempDir(), "state.json")
+	scan := func(finishCtx context.Context) {
+		ctx := context.Background()
+		e, err := NewEngine(ctx, &Config{
+			Concurrency:   1,
+			Decoders:      decoders.DefaultDecoders(),
+			Detectors:     defaults.DefaultDetectors(),
+			SourceManager: sources.NewManager(sources.WithSourceUnits(), sources.WithBufferedOutput(64)),
+			Dispatcher:    NewPrinterDispatcher(new(discardPrinter)),
+		})
+		require.NoError(t, err)
+		e.Start(ctx)
+
+		_, err = e.ScanGit(ctx, sources.GitConfig{URI: uri, HeadRef: "main", StateFile: statePath})
+		require.NoError(t, err)
+		_, err = os.Stat(statePath)
+		assert.ErrorIs(t, err, os.ErrNotExist, "the state must not be saved before the engine finishes")
+		require.NoError(t, e.Finish(finishCtx))
+	}
+
+	// A scan that is interrupted before it finishes keeps the old state.
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	scan(cancelled)
+	_, err := os.Stat(statePath)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	scan(context.Background())
+	state, err := git.LoadScanState(statePath)
+	require.NoError(t, err)
+	assert.Equal(t, tip, state.LastScanned(uri, "main"))
+}
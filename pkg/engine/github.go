@@ -28,6 +28,12 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.GithubConfig) (source
 		IncludeWikis:               c.IncludeWikis,
 		SkipBinaries:               c.SkipBinaries,
 		CommentsTimeframeDays:      c.CommentsTimeframeDays,
+		IncludeActionsLogs:         c.IncludeActionsLogs,
+		IncludeActionsArtifacts:    c.IncludeActionsArtifacts,
+		ActionsTimeframeDays:       c.ActionsTimeframeDays,
+		MaxActionsDownloadSize:     c.MaxActionsDownloadSize,
+		IncludeReleases:            c.IncludeReleases,
+		MaxReleaseAssetSize:        c.MaxReleaseAssetSize,
 		RemoveAuthInUrl:            !c.AuthInUrl, // configuration uses the opposite field in proto to keep credentials in the URL by default.
 	}
 	if len(c.Token) > 0 {
@@ -60,5 +66,5 @@ func (e *Engine) ScanGitHub(ctx context.Context, c sources.GithubConfig) (source
 		return sources.JobProgressRef{}, err
 	}
 	githubSource.WithScanOptions(scanOptions)
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, githubSource)
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, githubSource, &conn)
 }
@@ -25,8 +25,17 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.GitlabConfig) (source
 	scanOptions := git.NewScanOptions(opts...)
 
 	connection := &sourcespb.GitLab{
-		SkipBinaries:    c.SkipBinaries,
-		RemoveAuthInUrl: !c.AuthInUrl, // configuration uses the opposite field in proto to keep credentials in the URL by default.
+		SkipBinaries:                c.SkipBinaries,
+		RemoveAuthInUrl:             !c.AuthInUrl, // configuration uses the opposite field in proto to keep credentials in the URL by default.
+		IncludeIssueComments:        c.IncludeIssueComments,
+		IncludeMergeRequestComments: c.IncludeMergeRequestComments,
+		IncludeSnippets:             c.IncludeSnippets,
+		IncludeWikis:                c.IncludeWikis,
+		CommentsTimeframeDays:       c.CommentsTimeframeDays,
+		IncludePipelineLogs:         c.IncludePipelineLogs,
+		IncludePipelineArtifacts:    c.IncludePipelineArtifacts,
+		PipelinesTimeframeDays:      c.PipelinesTimeframeDays,
+		MaxPipelineDownloadSize:     c.MaxPipelineDownloadSize,
 	}
 
 	switch {
@@ -73,5 +82,5 @@ func (e *Engine) ScanGitLab(ctx context.Context, c sources.GitlabConfig) (source
 		return sources.JobProgressRef{}, err
 	}
 	gitlabSource.WithScanOptions(scanOptions)
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, gitlabSource)
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, gitlabSource, &conn)
 }
@@ -21,6 +21,10 @@ type JenkinsConfig struct {
 	Password              string
 	Header                string
 	InsecureSkipVerifyTLS bool
+	IncludeJobConfigs     bool
+	IncludeBuildDetails   bool
+	IncludeBuildArtifacts bool
+	MaxArtifactSize       int64
 }
 
 // ScanJenkins scans Jenkins logs.
@@ -62,6 +66,10 @@ func (e *Engine) ScanJenkins(ctx context.Context, jenkinsConfig JenkinsConfig) (
 
 	connection.Endpoint = jenkinsConfig.Endpoint
 	connection.InsecureSkipVerifyTls = jenkinsConfig.InsecureSkipVerifyTLS
+	connection.IncludeJobConfigs = jenkinsConfig.IncludeJobConfigs
+	connection.IncludeBuildDetails = jenkinsConfig.IncludeBuildDetails
+	connection.IncludeBuildArtifacts = jenkinsConfig.IncludeBuildArtifacts
+	connection.MaxArtifactSize = jenkinsConfig.MaxArtifactSize
 
 	var conn anypb.Any
 	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
@@ -77,5 +85,5 @@ func (e *Engine) ScanJenkins(ctx context.Context, jenkinsConfig JenkinsConfig) (
 	if err := jenkinsSource.Init(ctx, "trufflehog - Jenkins", jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
 	}
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, jenkinsSource)
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, jenkinsSource, &conn)
 }
@@ -26,7 +26,7 @@ func (e *Engine) ScanConfig(ctx context.Context, configuredSources ...sources.Co
 		}
 
 		// Start the scan.
-		ref, err := e.sourceManager.EnumerateAndScan(ctx, configuredSource.Name, source)
+		ref, err := e.sourceManager.EnumerateAndScanWithConfig(ctx, configuredSource.Name, source, configuredSource.Connection())
 		if err != nil {
 			return refs, err
 		}
@@ -0,0 +1,44 @@
+package engine
+
+import (
+	"runtime"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/slack"
+)
+
+// ScanSlack scans Slack messages and files, either through the API or from a
+// workspace export.
+func (e *Engine) ScanSlack(ctx context.Context, c sources.SlackConfig) (sources.JobProgressRef, error) {
+	connection := &sourcespb.Slack{
+		Endpoint:     c.Endpoint,
+		Channels:     c.Channels,
+		IgnoreList:   c.IgnoreChannels,
+		ExportPath:   c.ExportPath,
+		WorkspaceUrl: c.WorkspaceURL,
+	}
+	if c.Token != "" {
+		connection.Credential = &sourcespb.Slack_Token{Token: c.Token}
+	}
+
+	var conn anypb.Any
+	err := anypb.MarshalFrom(&conn, connection, proto.MarshalOptions{})
+	if err != nil {
+		ctx.Logger().Error(err, "failed to marshal slack connection")
+		return sources.JobProgressRef{}, err
+	}
+
+	sourceName := "trufflehog - slack"
+	sourceID, jobID, _ := e.sourceManager.GetIDs(ctx, sourceName, slack.SourceType)
+
+	slackSource := &slack.Source{}
+	if err := slackSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
+		return sources.JobProgressRef{}, err
+	}
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, slackSource, &conn)
+}
@@ -29,5 +29,5 @@ func (e *Engine) ScanStdinInput(ctx context.Context, c sources.StdinConfig) (sou
 	if err := stdinSource.Init(ctx, sourceName, jobID, sourceID, true, &conn, runtime.NumCPU()); err != nil {
 		return sources.JobProgressRef{}, err
 	}
-	return e.sourceManager.EnumerateAndScan(ctx, sourceName, stdinSource)
+	return e.sourceManager.EnumerateAndScanWithConfig(ctx, sourceName, stdinSource, &conn)
 }
@@ -230,18 +230,7 @@ func (c *Parser) RepoPath(
 	isBare bool,
 	additionalArgs ...string,
 ) (chan *Diff, error) {
-	args := []string{
-		"-C", source,
-		"log",
-		"--patch", // https://git-scm.com/docs/git-log#Documentation/git-log.txt---patch
-		"--full-history",
-		"--date=format:%a %b %d %H:%M:%S %Y %z",
-		"--pretty=fuller", // https://git-scm.com/docs/git-log#_pretty_formats
-		"--notes",         // https://git-scm.com/docs/git-log#Documentation/git-log.txt---notesltrefgt
-	}
-	if abbreviatedLog {
-		args = append(args, "--diff-filter=AM")
-	}
+	args := logArgs(source, abbreviatedLog)
 	if head != "" {
 		args = append(args, head)
 	} else {
@@ -253,28 +242,127 @@ func (c *Parser) RepoPath(
 	}
 
 	cmd := exec.Command("git", args...)
-	absPath, err := filepath.Abs(source)
-	if err == nil {
-		if !isBare {
-			cmd.Env = append(cmd.Env, "GIT_DIR="+filepath.Join(absPath, ".git"))
-		} else {
-			cmd.Env = append(cmd.Env,
-				"GIT_DIR="+absPath,
-			)
-			// We need those variables to handle incoming commits
-			// while using trufflehog in pre-receive hooks
-			if dir := os.Getenv("GIT_OBJECT_DIRECTORY"); dir != "" {
-				cmd.Env = append(cmd.Env, "GIT_OBJECT_DIRECTORY="+dir)
-			}
-			if dir := os.Getenv("GIT_ALTERNATE_OBJECT_DIRECTORIES"); dir != "" {
-				cmd.Env = append(cmd.Env, "GIT_ALTERNATE_OBJECT_DIRECTORIES="+dir)
+	setRepoEnv(cmd, source, isBare)
+
+	return c.executeCommand(ctx, cmd, false)
+}
+
+// RangesPath parses the output of the `git log` command for the commits in
+// the given `git rev-list` ranges of the `source` path, e.g. "main..feature".
+// Commits shared between ranges are only logged once, so history common to
+// many ranges is parsed a single time. It returns a nil channel if the ranges
+// contain no commits.
+func (c *Parser) RangesPath(
+	ctx context.Context,
+	source string,
+	ranges []string,
+	abbreviatedLog bool,
+	excludedGlobs []string,
+	isBare bool,
+) (chan *Diff, error) {
+	var (
+		commits []string
+		seen    = make(map[string]struct{})
+	)
+	for _, commitRange := range ranges {
+		if strings.HasPrefix(commitRange, "-") {
+			return nil, fmt.Errorf("invalid commit range %q", commitRange)
+		}
+		cmd := exec.Command("git", "-C", source, "rev-list", commitRange, "--")
+		setRepoEnv(cmd, source, isBare)
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("error listing commits of range %q: %w", commitRange, err)
+		}
+		for _, hash := range strings.Fields(string(out)) {
+			if _, ok := seen[hash]; ok {
+				continue
 			}
+			seen[hash] = struct{}{}
+			commits = append(commits, hash)
 		}
 	}
+	ctx.Logger().V(2).Info("listed commits of ranges", "ranges", len(ranges), "commits", len(commits))
+	if len(commits) == 0 {
+		return nil, nil
+	}
+
+	args := append(logArgs(source, abbreviatedLog), "--no-walk", "--stdin")
+	return c.logCommits(ctx, source, commits, args, excludedGlobs, isBare)
+}
+
+// CommitsPath parses the output of the `git log` command for exactly the
+// given commits of the `source` path, without walking their history. Merge
+// commits, such as stash entries, are diffed against their first parent.
+func (c *Parser) CommitsPath(
+	ctx context.Context,
+	source string,
+	commits []string,
+	excludedGlobs []string,
+	isBare bool,
+) (chan *Diff, error) {
+	if len(commits) == 0 {
+		return nil, nil
+	}
+	args := append(logArgs(source, false), "--no-walk", "--diff-merges=first-parent", "--stdin")
+	return c.logCommits(ctx, source, commits, args, excludedGlobs, isBare)
+}
+
+// logCommits runs `git log` with args, passing it commits on stdin, as there
+// may be too many for the command line.
+func (c *Parser) logCommits(ctx context.Context, source string, commits, args, excludedGlobs []string, isBare bool) (chan *Diff, error) {
+	for _, glob := range excludedGlobs {
+		args = append(args, "--", ".", ":(exclude)"+glob)
+	}
+
+	cmd := exec.Command("git", args...)
+	setRepoEnv(cmd, source, isBare)
+	cmd.Stdin = strings.NewReader(strings.Join(commits, "\n") + "\n")
 
 	return c.executeCommand(ctx, cmd, false)
 }
 
+// logArgs returns the `git log` arguments used to parse the commits of the
+// `source` path, without the revisions to log.
+func logArgs(source string, abbreviatedLog bool) []string {
+	args := []string{
+		"-C", source,
+		"log",
+		"--patch", // https://git-scm.com/docs/git-log#Documentation/git-log.txt---patch
+		"--full-history",
+		"--date=format:%a %b %d %H:%M:%S %Y %z",
+		"--pretty=fuller", // https://git-scm.com/docs/git-log#_pretty_formats
+		"--notes",         // https://git-scm.com/docs/git-log#Documentation/git-log.txt---notesltrefgt
+	}
+	if abbreviatedLog {
+		args = append(args, "--diff-filter=AM")
+	}
+	return args
+}
+
+// setRepoEnv points cmd at the git directory of the `source` path.
+func setRepoEnv(cmd *exec.Cmd, source string, isBare bool) {
+	absPath, err := filepath.Abs(source)
+	if err != nil {
+		return
+	}
+	if !isBare {
+		cmd.Env = append(cmd.Env, "GIT_DIR="+filepath.Join(absPath, ".git"))
+		return
+	}
+	cmd.Env = append(cmd.Env,
+		"GIT_DIR="+absPath,
+	)
+	// We need those variables to handle incoming commits
+	// while using trufflehog in pre-receive hooks
+	if dir := os.Getenv("GIT_OBJECT_DIRECTORY"); dir != "" {
+		cmd.Env = append(cmd.Env, "GIT_OBJECT_DIRECTORY="+dir)
+	}
+	if dir := os.Getenv("GIT_ALTERNATE_OBJECT_DIRECTORIES"); dir != "" {
+		cmd.Env = append(cmd.Env, "GIT_ALTERNATE_OBJECT_DIRECTORIES="+dir)
+	}
+}
+
 // Staged parses the output of the `git diff` command for the `source` path.
 func (c *Parser) Staged(ctx context.Context, source string) (chan *Diff, error) {
 	// Provide the --cached flag to diff to get the diff of the staged changes.
@@ -286,6 +374,11 @@ func (c *Parser) Staged(ctx context.Context, source string) (chan *Diff, error)
 	if err == nil {
 		cmd.Env = append(cmd.Env, "GIT_DIR="+filepath.Join(absPath, ".git"))
 	}
+	// `git commit -a` and `git commit <path>` stage into a temporary index
+	// and pass it to pre-commit hooks through GIT_INDEX_FILE.
+	if index := os.Getenv("GIT_INDEX_FILE"); index != "" {
+		cmd.Env = append(cmd.Env, "GIT_INDEX_FILE="+index)
+	}
 
 	return c.executeCommand(ctx, cmd, true)
 }
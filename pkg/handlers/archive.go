@@ -128,6 +128,11 @@ func (h *archiveHandler) openArchive(
 
 	if reader.format == nil {
 		if depth > 0 {
+			// Office documents and PDFs aren't identified as archives, so
+			// extract their text as the document handler would.
+			if _, ok := documentMimeTypes[mimeType(reader.mime.String())]; ok {
+				return newDocumentHandler().processDocument(ctx, depth, reader, dataOrErrChan)
+			}
 			return h.handleNonArchiveContent(ctx, newMimeTypeReaderFromFileReader(reader), dataOrErrChan)
 		}
 		return fmt.Errorf("unknown archive format")
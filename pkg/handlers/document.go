@@ -0,0 +1,828 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// documentMimeTypes are the office document formats documentHandler extracts
+// text from.
+var documentMimeTypes = map[mimeType]struct{}{
+	docxMime: {},
+	xlsxMime: {},
+	pptxMime: {},
+	odtMime:  {},
+	odsMime:  {},
+	odpMime:  {},
+	pdfMime:  {},
+}
+
+// documentExtensions maps the extensions of office documents to their MIME
+// type. OOXML documents are zip files whose MIME type can only be detected
+// if the right entries appear early in the file, so the extension is used to
+// recognize those that are detected as plain zip files.
+var documentExtensions = map[string]mimeType{
+	".docx": docxMime,
+	".docm": docxMime,
+	".xlsx": xlsxMime,
+	".xlsm": xlsxMime,
+	".pptx": pptxMime,
+	".pptm": pptxMime,
+	".odt":  odtMime,
+	".ods":  odsMime,
+	".odp":  odpMime,
+}
+
+// documentMimeForExtension returns the MIME type of an office document that was
+// detected as a plain zip file, based on its file extension.
+func documentMimeForExtension(cfg readerConfig, fReader fileReader) (*mimetype.MIME, bool) {
+	if fReader.mime.String() != string(zipMime) {
+		return nil, false
+	}
+	mimeT, ok := documentExtensions[strings.ToLower(cfg.fileExtension)]
+	if !ok {
+		return nil, false
+	}
+	mime := mimetype.Lookup(string(mimeT))
+	return mime, mime != nil
+}
+
+// documentHandler extracts the text of office documents (OOXML and ODF) and
+// PDFs. Scanning the text a reader sees, rather than the XML or compressed
+// streams it's stored in, finds secrets that are split up by formatting
+// markup or hidden inside compressed content.
+//
+// Text is emitted per page, sheet or slide, with its location recorded in the
+// chunk's metadata. The parts of OOXML documents that no text is extracted
+// from, such as embedded files and data connections, are scanned the way the
+// archive handler scans zip entries.
+type documentHandler struct{ *defaultHandler }
+
+// newDocumentHandler creates a documentHandler.
+func newDocumentHandler() *documentHandler {
+	return &documentHandler{defaultHandler: newDefaultHandler(documentHandlerType)}
+}
+
+// HandleFile extracts the text of office documents and PDFs.
+//
+// Fatal errors that will terminate processing include:
+// - Context cancellation or deadline exceeded
+// - Errors writing to the data channel
+// - Panics during processing (wrapped as ErrProcessingFatal)
+//
+// Documents whose text can't be extracted, e.g. because they are corrupt, are
+// scanned as they are instead.
+func (h *documentHandler) HandleFile(ctx logContext.Context, input fileReader) chan DataOrErr {
+	dataOrErrChan := make(chan DataOrErr, defaultBufferSize)
+
+	go func() {
+		defer close(dataOrErrChan)
+
+		// Defer a panic recovery to handle any panics that occur while parsing the document.
+		defer func() {
+			if r := recover(); r != nil {
+				var panicErr error
+				if e, ok := r.(error); ok {
+					panicErr = e
+				} else {
+					panicErr = fmt.Errorf("panic occurred: %v", r)
+				}
+				dataOrErrChan <- DataOrErr{
+					Err: fmt.Errorf("%w: panic error: %v", ErrProcessingFatal, panicErr),
+				}
+			}
+		}()
+
+		start := time.Now()
+		err := h.processDocument(ctx, 0, input, dataOrErrChan)
+		if err == nil {
+			h.metrics.incFilesProcessed()
+		}
+
+		// Update the metrics for the file processing and handle any errors.
+		h.measureLatencyAndHandleErrors(ctx, start, err, dataOrErrChan)
+	}()
+
+	return dataOrErrChan
+}
+
+// emitFunc receives a section of a document's text, such as a page, along
+// with its location, which is empty for the main text of a document.
+type emitFunc func(location string, text []byte) error
+
+// processDocument extracts the text of a document found at the given archive
+// depth.
+func (h *documentHandler) processDocument(ctx logContext.Context, depth int, input fileReader, dataOrErrChan chan DataOrErr) error {
+	size, err := input.Size()
+	if err != nil {
+		return fmt.Errorf("unable to determine document size: %w", err)
+	}
+	if size > int64(maxSize) {
+		ctx.Logger().V(2).Info("skipping document: size exceeds max allowed", "size", size, "limit", maxSize)
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+	h.metrics.observeFileSize(size)
+
+	emit := func(location string, text []byte) error {
+		return h.emitText(ctx, location, text, dataOrErrChan)
+	}
+
+	mimeT := mimeType(input.mime.String())
+	switch mimeT {
+	case docxMime, xlsxMime, pptxMime:
+		var parts []*zip.File
+		if parts, err = extractOOXML(input, size, emit); err == nil {
+			err = h.scanZipParts(ctx, depth, parts, dataOrErrChan)
+		}
+	case odtMime, odsMime, odpMime:
+		err = extractODF(input, size, mimeT, emit)
+	case pdfMime:
+		err = extractPDF(input, size, emit)
+	default:
+		err = fmt.Errorf("unsupported document type %q", mimeT)
+	}
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ctx.Err()) || isFatal(err):
+		return err
+	case errors.Is(err, errEncryptedPDF):
+		ctx.Logger().V(3).Info("skipping encrypted document")
+		h.metrics.incFilesSkipped()
+		return nil
+	}
+
+	// Anything already emitted is scanned again, but a corrupt document
+	// shouldn't hide secrets that are visible in its raw bytes.
+	ctx.Logger().V(2).Info("unable to extract document text, scanning it as is", "error", err)
+	if _, err := input.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("%w: error resetting reader: %v", ErrProcessingFatal, err)
+	}
+	return h.handleNonArchiveContent(ctx, newMimeTypeReaderFromFileReader(input), dataOrErrChan)
+}
+
+// emitText splits text into chunks and writes them to the data channel.
+func (h *documentHandler) emitText(ctx logContext.Context, location string, text []byte, dataOrErrChan chan DataOrErr) error {
+	if len(bytes.TrimSpace(text)) == 0 {
+		return nil
+	}
+
+	chunkReader := sources.NewChunkReader()
+	for data := range chunkReader(ctx, bytes.NewReader(text)) {
+		if err := data.Error(); err != nil {
+			return fmt.Errorf("%w: error reading chunk: %v", ErrProcessingWarning, err)
+		}
+		dataOrErr := DataOrErr{Data: data.Bytes(), Location: location}
+		if err := common.CancellableWrite(ctx, dataOrErrChan, dataOrErr); err != nil {
+			return err
+		}
+		h.metrics.incBytesProcessed(len(data.Bytes()))
+	}
+	return nil
+}
+
+// scanZipParts scans the parts of a zip based document that no text was
+// extracted from, nested archives included, as if they were entries of a zip
+// file found at the given depth.
+func (h *documentHandler) scanZipParts(ctx logContext.Context, depth int, parts []*zip.File, dataOrErrChan chan DataOrErr) error {
+	archive := newArchiveHandler()
+	for _, part := range parts {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+
+		lCtx := logContext.WithValues(ctx, "part", part.Name)
+		if part.FileInfo().IsDir() || part.UncompressedSize64 == 0 {
+			continue
+		}
+		if part.UncompressedSize64 > uint64(maxSize) || common.SkipFile(part.Name) || common.IsBinary(part.Name) {
+			lCtx.Logger().V(4).Info("skipping document part")
+			h.metrics.incFilesSkipped()
+			continue
+		}
+
+		err := func() error {
+			rc, err := part.Open()
+			if err != nil {
+				return fmt.Errorf("%w: unable to open part: %v", ErrProcessingWarning, err)
+			}
+			defer rc.Close()
+
+			rdr, err := newFileReader(lCtx, rc, withFileExtension(path.Ext(part.Name)))
+			if err != nil {
+				if errors.Is(err, ErrEmptyReader) {
+					return nil
+				}
+				return fmt.Errorf("%w: unable to read part: %v", ErrProcessingWarning, err)
+			}
+			defer rdr.Close()
+
+			return archive.openArchive(lCtx, depth+1, rdr, dataOrErrChan)
+		}()
+		switch {
+		case err == nil:
+		case errors.Is(err, ctx.Err()) || isFatal(err):
+			return err
+		default:
+			lCtx.Logger().V(2).Info("unable to scan document part", "error", err)
+		}
+	}
+	return nil
+}
+
+// maxDocumentPartSize limits how much of a single part of a zip based document
+// is read, to guard against decompression bombs.
+const maxDocumentPartSize = 256 << 20 // 256 MB
+
+// openZipPart returns a reader for the named part of a zip based document.
+func openZipPart(files map[string]*zip.File, name string) (io.ReadCloser, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("document has no %s part", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s: %w", name, err)
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.LimitReader(rc, maxDocumentPartSize), rc}, nil
+}
+
+// zipFiles indexes the entries of a zip file by name.
+func zipFiles(r io.ReaderAt, size int64) (map[string]*zip.File, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read document: %w", err)
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[strings.TrimPrefix(f.Name, "/")] = f
+	}
+	return files, nil
+}
+
+// extractOOXML extracts the text of Word documents, Excel workbooks and
+// PowerPoint presentations. It returns the remaining parts of the document,
+// sorted by name.
+func extractOOXML(r io.ReaderAt, size int64, emit emitFunc) ([]*zip.File, error) {
+	files, err := zipFiles(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	var extracted []string
+	switch {
+	case files["word/document.xml"] != nil:
+		extracted, err = extractDocx(files, emit)
+	case files["xl/workbook.xml"] != nil:
+		extracted, err = extractXlsx(files, emit)
+	case files["ppt/presentation.xml"] != nil:
+		extracted, err = extractPptx(files, emit)
+	default:
+		err = errors.New("unrecognized OOXML document")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range extracted {
+		delete(files, name)
+	}
+	parts := make([]*zip.File, 0, len(files))
+	for _, f := range files {
+		parts = append(parts, f)
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Name < parts[j].Name })
+	return parts, nil
+}
+
+// extractDocx emits the body of a Word document, followed by its headers,
+// footers, notes and comments. It returns the names of the parts it emitted.
+func extractDocx(files map[string]*zip.File, emit emitFunc) ([]string, error) {
+	if err := emitXMLText(files, "word/document.xml", "", emit); err != nil {
+		return nil, err
+	}
+	extracted := []string{"word/document.xml"}
+
+	parts := []struct{ prefix, location string }{
+		{"word/header", "header"},
+		{"word/footer", "footer"},
+		{"word/footnotes", "footnotes"},
+		{"word/endnotes", "endnotes"},
+		{"word/comments", "comments"},
+	}
+	for _, part := range parts {
+		for _, name := range sortedParts(files, part.prefix) {
+			if err := emitXMLText(files, name, part.location, emit); err != nil {
+				return nil, err
+			}
+			extracted = append(extracted, name)
+		}
+	}
+	return extracted, nil
+}
+
+// extractPptx emits the text of each slide of a presentation, including its
+// speaker notes, in presentation order. It returns the names of the parts it
+// emitted.
+func extractPptx(files map[string]*zip.File, emit emitFunc) ([]string, error) {
+	slides, err := relatedParts(files, "ppt/presentation.xml", "sldId")
+	if err != nil {
+		return nil, err
+	}
+	var extracted []string
+	for i, slide := range slides {
+		text, err := readXMLText(files, slide)
+		if err != nil {
+			return nil, err
+		}
+		extracted = append(extracted, slide)
+		notes, err := relatedParts(files, slide, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range notes {
+			if !strings.HasPrefix(part, "ppt/notesSlides/") {
+				continue
+			}
+			noteText, err := readXMLText(files, part)
+			if err != nil {
+				return nil, err
+			}
+			text = append(text, noteText...)
+			extracted = append(extracted, part)
+		}
+		if err := emit(fmt.Sprintf("slide %d", i+1), text); err != nil {
+			return nil, err
+		}
+	}
+	return extracted, nil
+}
+
+// extractXlsx emits the cells of each sheet of a workbook, one row per line
+// with cells separated by tabs. It returns the names of the parts it emitted.
+func extractXlsx(files map[string]*zip.File, emit emitFunc) ([]string, error) {
+	sharedStrings, err := readSharedStrings(files)
+	if err != nil {
+		return nil, err
+	}
+
+	rels, err := readRelationships(files, "xl/workbook.xml")
+	if err != nil {
+		return nil, err
+	}
+	rc, err := openZipPart(files, "xl/workbook.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	type sheet struct{ name, part string }
+	var sheets []sheet
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse workbook: %w", err)
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == "sheet" {
+			if part, ok := rels[relationshipID(se)]; ok {
+				sheets = append(sheets, sheet{name: xmlAttr(se, "name"), part: part})
+			}
+		}
+	}
+
+	var extracted []string
+	if sharedStrings != nil {
+		extracted = append(extracted, "xl/sharedStrings.xml")
+	}
+	for _, s := range sheets {
+		text, err := readSheet(files, s.part, sharedStrings)
+		if err != nil {
+			return nil, err
+		}
+		if err := emit("sheet "+s.name, text); err != nil {
+			return nil, err
+		}
+		extracted = append(extracted, s.part)
+	}
+	return extracted, nil
+}
+
+// readSharedStrings reads the strings that workbook cells refer to by index.
+func readSharedStrings(files map[string]*zip.File) ([]string, error) {
+	if files["xl/sharedStrings.xml"] == nil {
+		return nil, nil
+	}
+	rc, err := openZipPart(files, "xl/sharedStrings.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		stringsTable []string
+		current      strings.Builder
+		inText       bool
+		phonetic     int
+	)
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return stringsTable, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse shared strings: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				current.Reset()
+			case "t":
+				inText = true
+			case "rPh":
+				// Phonetic hints repeat the string in another script.
+				phonetic++
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				stringsTable = append(stringsTable, current.String())
+			case "t":
+				inText = false
+			case "rPh":
+				phonetic--
+			}
+		case xml.CharData:
+			if inText && phonetic == 0 {
+				current.Write(t)
+			}
+		}
+	}
+}
+
+// readSheet reads the cells of a worksheet.
+func readSheet(files map[string]*zip.File, name string, sharedStrings []string) ([]byte, error) {
+	rc, err := openZipPart(files, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		buf       bytes.Buffer
+		cellType  string
+		value     strings.Builder
+		inValue   bool
+		cellCount int
+	)
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return buf.Bytes(), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", name, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				cellCount = 0
+			case "c":
+				cellType = xmlAttr(t, "t")
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "row":
+				if cellCount > 0 {
+					buf.WriteByte('\n')
+				}
+			case "c":
+				cell := value.String()
+				if cellType == "s" {
+					idx, err := strconv.Atoi(strings.TrimSpace(cell))
+					if err != nil || idx < 0 || idx >= len(sharedStrings) {
+						continue
+					}
+					cell = sharedStrings[idx]
+				}
+				if cell == "" {
+					continue
+				}
+				if cellCount > 0 {
+					buf.WriteByte('\t')
+				}
+				buf.WriteString(cell)
+				cellCount++
+			case "v", "t":
+				inValue = false
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+}
+
+// relatedParts returns the parts that the relationships of the named part
+// point to. If element is set, only the parts referenced by the r:id
+// attributes of those elements in the part are returned, in the order they
+// appear. Otherwise all related parts are returned, sorted by name.
+func relatedParts(files map[string]*zip.File, name, element string) ([]string, error) {
+	rels, err := readRelationships(files, name)
+	if err != nil {
+		return nil, err
+	}
+	if element == "" {
+		parts := make([]string, 0, len(rels))
+		for _, part := range rels {
+			parts = append(parts, part)
+		}
+		sort.Strings(parts)
+		return parts, nil
+	}
+
+	rc, err := openZipPart(files, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var parts []string
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", name, err)
+		}
+		if se, ok := tok.(xml.StartElement); ok && se.Name.Local == element {
+			if part, ok := rels[relationshipID(se)]; ok {
+				parts = append(parts, part)
+			}
+		}
+	}
+}
+
+// readRelationships maps the relationship IDs of the named part to the parts
+// they point to. External targets, such as hyperlinks, are left out; they are
+// scanned along with the rest of the relationships part.
+func readRelationships(files map[string]*zip.File, name string) (map[string]string, error) {
+	dir, base := path.Split(name)
+	relsName := dir + "_rels/" + base + ".rels"
+	rels := make(map[string]string)
+	if files[relsName] == nil {
+		return rels, nil
+	}
+
+	rc, err := openZipPart(files, relsName)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return rels, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", relsName, err)
+		}
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Local != "Relationship" || xmlAttr(se, "TargetMode") == "External" {
+			continue
+		}
+		target := xmlAttr(se, "Target")
+		if strings.HasPrefix(target, "/") {
+			target = strings.TrimPrefix(target, "/")
+		} else {
+			target = path.Join(dir, target)
+		}
+		rels[xmlAttr(se, "Id")] = target
+	}
+}
+
+// relationshipID returns the r:id attribute of an element.
+func relationshipID(se xml.StartElement) string {
+	for _, attr := range se.Attr {
+		if attr.Name.Local == "id" && attr.Name.Space != "" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// xmlAttr returns the value of the attribute with the given local name.
+func xmlAttr(se xml.StartElement, local string) string {
+	for _, attr := range se.Attr {
+		if attr.Name.Local == local {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// sortedParts returns the names of the parts starting with prefix, e.g.
+// word/header1.xml and word/header2.xml for "word/header".
+func sortedParts(files map[string]*zip.File, prefix string) []string {
+	var names []string
+	for name := range files {
+		if strings.HasPrefix(name, prefix) && strings.HasSuffix(name, ".xml") && !strings.Contains(name[len(prefix):], "/") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// emitXMLText emits the text of a WordprocessingML or DrawingML part.
+func emitXMLText(files map[string]*zip.File, name, location string, emit emitFunc) error {
+	text, err := readXMLText(files, name)
+	if err != nil {
+		return err
+	}
+	return emit(location, text)
+}
+
+// readXMLText reads the text of a WordprocessingML or DrawingML part. Text
+// runs are joined and paragraphs end with a newline. Deleted text from
+// tracked changes and field instructions, which hold the targets of links,
+// are included too.
+func readXMLText(files map[string]*zip.File, name string) ([]byte, error) {
+	rc, err := openZipPart(files, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		buf    bytes.Buffer
+		inText bool
+	)
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return buf.Bytes(), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s: %w", name, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t", "delText", "instrText":
+				inText = true
+			case "tab":
+				buf.WriteByte('\t')
+			case "br", "cr":
+				buf.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t", "delText", "instrText":
+				inText = false
+			case "p":
+				buf.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				buf.Write(t)
+			}
+		}
+	}
+}
+
+// extractODF extracts the text of OpenDocument text documents, spreadsheets
+// and presentations. Spreadsheets are emitted per sheet and presentations per
+// slide.
+func extractODF(r io.ReaderAt, size int64, mimeT mimeType, emit emitFunc) error {
+	files, err := zipFiles(r, size)
+	if err != nil {
+		return err
+	}
+	rc, err := openZipPart(files, "content.xml")
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// The element that starts a new section, if the document has them.
+	var sectionElement string
+	switch mimeT {
+	case odsMime:
+		sectionElement = "table"
+	case odpMime:
+		sectionElement = "page"
+	}
+
+	var (
+		buf       bytes.Buffer
+		location  string
+		slides    int
+		paragraph int
+		depth     int
+		// sectionDepth is the depth of the current section's element, so
+		// that tables nested in a sheet don't start new sections.
+		sectionDepth int
+	)
+	flush := func() error {
+		err := emit(location, buf.Bytes())
+		buf.Reset()
+		return err
+	}
+
+	dec := xml.NewDecoder(rc)
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			return flush()
+		}
+		if err != nil {
+			return fmt.Errorf("unable to parse content.xml: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			switch t.Name.Local {
+			case sectionElement:
+				if sectionDepth != 0 {
+					break
+				}
+				if err := flush(); err != nil {
+					return err
+				}
+				sectionDepth = depth
+				if mimeT == odsMime {
+					location = "sheet " + xmlAttr(t, "name")
+				} else {
+					slides++
+					location = fmt.Sprintf("slide %d", slides)
+				}
+			case "p", "h":
+				paragraph++
+			case "s":
+				n, err := strconv.Atoi(xmlAttr(t, "c"))
+				if err != nil || n < 1 {
+					n = 1
+				}
+				buf.WriteString(strings.Repeat(" ", min(n, 1024)))
+			case "tab":
+				buf.WriteByte('\t')
+			case "line-break":
+				buf.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "p", "h":
+				paragraph--
+				buf.WriteByte('\n')
+			case "table-cell":
+				buf.WriteByte('\t')
+			case "table-row":
+				buf.WriteByte('\n')
+			}
+			if depth == sectionDepth {
+				sectionDepth = 0
+			}
+			depth--
+		case xml.CharData:
+			if paragraph > 0 {
+				buf.Write(t)
+			}
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gabriel-vasile/mimetype"
 	"github.com/mholt/archives"
+	"google.golang.org/protobuf/proto"
 
 	logContext "github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/feature"
@@ -146,9 +147,14 @@ func newFileReader(ctx context.Context, r io.Reader, options ...readerOption) (f
 		}
 	}
 
+	// Office documents are zip files that aren't always detected as such.
+	if mime, ok := documentMimeForExtension(cfg, fReader); ok {
+		fReader.mime = mime
+	}
+
 	// If a MIME type is known to not be an archive type, we might as well return here rather than
 	// paying the I/O penalty of an archiver.Identify() call that won't identify anything.
-	if _, ok := skipArchiverMimeTypes[mimeType(mime.String())]; ok {
+	if _, ok := skipArchiverMimeTypes[mimeType(fReader.mime.String())]; ok {
 		return fReader, nil
 	}
 
@@ -184,6 +190,9 @@ func newFileReader(ctx context.Context, r io.Reader, options ...readerOption) (f
 type DataOrErr struct {
 	Data []byte
 	Err  error
+	// Location optionally describes where in the file Data came from, such as
+	// "page 2" of a PDF. It is recorded in the chunk's metadata.
+	Location string
 }
 
 // FileHandler represents a handler for files.
@@ -216,12 +225,13 @@ func WithSkipArchives(skip bool) func(*fileHandlingConfig) {
 type handlerType string
 
 const (
-	archiveHandlerType handlerType = "archive"
-	arHandlerType      handlerType = "ar"
-	rpmHandlerType     handlerType = "rpm"
-	apkHandlerType     handlerType = "apk"
-	defaultHandlerType handlerType = "default"
-	apkExt                         = ".apk"
+	archiveHandlerType  handlerType = "archive"
+	arHandlerType       handlerType = "ar"
+	rpmHandlerType      handlerType = "rpm"
+	apkHandlerType      handlerType = "apk"
+	documentHandlerType handlerType = "document"
+	defaultHandlerType  handlerType = "default"
+	apkExt                          = ".apk"
 )
 
 type mimeType string
@@ -259,6 +269,13 @@ const (
 	apkMime      mimeType = "application/vnd.android.package-archive"
 	zipMime      mimeType = "application/zip"
 	jarMime      mimeType = "application/java-archive"
+	pdfMime      mimeType = "application/pdf"
+	docxMime     mimeType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	xlsxMime     mimeType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	pptxMime     mimeType = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	odtMime      mimeType = "application/vnd.oasis.opendocument.text"
+	odsMime      mimeType = "application/vnd.oasis.opendocument.spreadsheet"
+	odpMime      mimeType = "application/vnd.oasis.opendocument.presentation"
 )
 
 // skipArchiverMimeTypes is a set of MIME types that should bypass archiver library processing because they are either
@@ -294,6 +311,13 @@ var skipArchiverMimeTypes = map[mimeType]struct{}{
 	tclTextMime:  {},
 	tclMime:      {},
 	apkMime:      {},
+	pdfMime:      {},
+	docxMime:     {},
+	xlsxMime:     {},
+	pptxMime:     {},
+	odtMime:      {},
+	odsMime:      {},
+	odpMime:      {},
 }
 
 // selectHandler dynamically selects and configures a FileHandler based on the provided |mimetype| type and archive flag.
@@ -302,6 +326,7 @@ var skipArchiverMimeTypes = map[mimeType]struct{}{
 // - arHandler is used for Unix archives and Debian packages ('arMime', 'unixArMime', and 'debMime').
 // - rpmHandler is used for RPM and CPIO archives ('rpmMime' and 'cpioMime').
 // - apkHandler is used for APK archives ('apkMime').
+// - documentHandler is used for office documents and PDFs (see documentMimeTypes).
 // - archiveHandler is used for common archive formats supported by the archiver library (.zip, .tar, .gz, etc.).
 // - defaultHandler is used for non-archive files.
 // The selected handler is then returned, ready to handle the file according to its specific format and requirements.
@@ -314,6 +339,9 @@ func selectHandler(mimeT mimeType, isGenericArchive bool) FileHandler {
 	case apkMime:
 		return newAPKHandler()
 	default:
+		if _, ok := documentMimeTypes[mimeT]; ok {
+			return newDocumentHandler()
+		}
 		if isGenericArchive {
 			return newArchiveHandler()
 		}
@@ -423,6 +451,9 @@ func handleChunksWithError(
 			if len(dataOrErr.Data) > 0 {
 				chunk := *chunkSkel
 				chunk.Data = dataOrErr.Data
+				if dataOrErr.Location != "" {
+					chunk.SourceMetadata = withLocation(chunk.SourceMetadata, dataOrErr.Location)
+				}
 				if err := reporter.ChunkOk(ctx, chunk); err != nil {
 					return fmt.Errorf("error reporting chunk: %w", err)
 				}
@@ -433,6 +464,16 @@ func handleChunksWithError(
 	}
 }
 
+// withLocation returns a copy of metadata with its location set.
+func withLocation(metadata *source_metadatapb.MetaData, location string) *source_metadatapb.MetaData {
+	if metadata == nil {
+		return &source_metadatapb.MetaData{Location: location}
+	}
+	metadata = proto.Clone(metadata).(*source_metadatapb.MetaData)
+	metadata.Location = location
+	return metadata
+}
+
 // isFatal determines whether the given error is a fatal error that should
 // terminate processing the current file, or a non-critical error that can be logged and ignored.
 // "Fatal" errors include context cancellation, deadline exceeded, and the
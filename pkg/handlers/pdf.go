@@ -0,0 +1,1117 @@
+package handlers
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// errEncryptedPDF is returned for PDFs whose strings and streams are
+// encrypted, since their text can't be extracted without the password.
+var errEncryptedPDF = errors.New("PDF is encrypted")
+
+// maxPDFStreamSize limits how large a decompressed PDF stream can get, to
+// guard against decompression bombs.
+const maxPDFStreamSize = 64 << 20 // 64 MB
+
+var (
+	// maxPDFSize limits the size of the PDFs whose text is extracted, since
+	// the whole file is read into memory. Larger PDFs are scanned as they are.
+	maxPDFSize = 32 << 20 // 32 MB
+	// maxPDFDecodedSize limits the total size of the decoded streams of a
+	// PDF, which are kept while its pages are read.
+	maxPDFDecodedSize = 128 << 20 // 128 MB
+)
+
+// maxPDFFormDepth limits how deeply form XObjects can be nested within each
+// other.
+const maxPDFFormDepth = 8
+
+// The PDF object types produced by pdfParser.
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfString  []byte
+	pdfArray   []any
+	pdfDict    map[pdfName]any
+	pdfRef     struct{ num int }
+)
+
+// pdfObject is an indirect object of a PDF file, with its stream data still
+// encoded.
+type pdfObject struct {
+	value  any
+	stream []byte
+}
+
+// pdfDocument is a parsed PDF file.
+type pdfDocument struct {
+	objects map[int]*pdfObject
+	// order lists the object numbers in the order their objects appear in
+	// the file, for documents without a usable page tree.
+	order []int
+	// decoded caches the decoded streams of objects, and decodedSize is
+	// their total size.
+	decoded     map[int][]byte
+	decodedSize int
+	// fonts caches the text decoders of font objects.
+	fonts map[int]*pdfFont
+}
+
+var pdfObjectHeader = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+// extractPDF extracts the text of each page of a PDF, including the targets of
+// links. Text is read from the page content streams, and mapped to Unicode
+// using the fonts' ToUnicode CMaps where present.
+func extractPDF(r io.ReaderAt, size int64, emit emitFunc) error {
+	if size > int64(maxPDFSize) {
+		return fmt.Errorf("PDF size %d exceeds the %d byte extraction limit", size, maxPDFSize)
+	}
+	data := make([]byte, size)
+	if _, err := r.ReadAt(data, 0); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unable to read PDF: %w", err)
+	}
+
+	doc := parsePDF(data)
+	if len(doc.objects) == 0 {
+		return errors.New("no PDF objects found")
+	}
+	if doc.encrypted(data) {
+		return errEncryptedPDF
+	}
+
+	for i, page := range doc.pages() {
+		if err := emit(fmt.Sprintf("page %d", i+1), doc.pageText(page)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parsePDF finds the indirect objects of a PDF, including those stored in
+// object streams. Objects are found by scanning the file rather than through
+// its cross-reference table, which is often broken in files that were edited
+// by hand. Later definitions of an object replace earlier ones, as they do
+// in incrementally updated files.
+func parsePDF(data []byte) *pdfDocument {
+	doc := &pdfDocument{
+		objects: make(map[int]*pdfObject),
+		decoded: make(map[int][]byte),
+		fonts:   make(map[int]*pdfFont),
+	}
+
+	end := 0
+	for _, m := range pdfObjectHeader.FindAllSubmatchIndex(data, -1) {
+		// Skip matches inside the stream of the previous object.
+		if m[0] < end || (m[0] > 0 && !isPDFDelimiter(data[m[0]-1]) && !isPDFSpace(data[m[0]-1])) {
+			continue
+		}
+		num, err := strconv.Atoi(string(data[m[2]:m[3]]))
+		if err != nil {
+			continue
+		}
+
+		p := &pdfParser{data: data, pos: m[1]}
+		value, err := p.readObject()
+		if err != nil {
+			continue
+		}
+		obj := &pdfObject{value: value}
+		end = p.pos
+		if dict, ok := value.(pdfDict); ok {
+			if stream, streamEnd, ok := readPDFStream(data, p.pos, dict); ok {
+				obj.stream = stream
+				end = streamEnd
+			}
+		}
+
+		if _, seen := doc.objects[num]; !seen {
+			doc.order = append(doc.order, num)
+		}
+		doc.objects[num] = obj
+	}
+
+	// Objects in object streams are only used if they aren't defined
+	// directly, since direct definitions are either newer or the same.
+	for _, num := range append([]int(nil), doc.order...) {
+		obj := doc.objects[num]
+		if dict, ok := obj.value.(pdfDict); ok && dict["Type"] == pdfName("ObjStm") {
+			doc.readObjectStream(num, dict)
+		}
+	}
+	return doc
+}
+
+// readPDFStream returns the raw data of the stream that follows a stream
+// object's dictionary at pos, and the position after it.
+func readPDFStream(data []byte, pos int, dict pdfDict) ([]byte, int, bool) {
+	p := &pdfParser{data: data, pos: pos}
+	p.skipSpace()
+	if !bytes.HasPrefix(data[p.pos:], []byte("stream")) {
+		return nil, 0, false
+	}
+	start := p.pos + len("stream")
+	switch {
+	case bytes.HasPrefix(data[start:], []byte("\r\n")):
+		start += 2
+	case start < len(data) && (data[start] == '\n' || data[start] == '\r'):
+		start++
+	}
+
+	// Trust the stream's length if it's given directly and ends where the
+	// stream does, otherwise look for the end of the stream.
+	if length, ok := dict["Length"].(float64); ok && length >= 0 && start+int(length) <= len(data) {
+		streamEnd := start + int(length)
+		rest := bytes.TrimLeft(data[streamEnd:min(streamEnd+16, len(data))], "\r\n \t")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			return data[start:streamEnd], streamEnd, true
+		}
+	}
+	idx := bytes.Index(data[start:], []byte("endstream"))
+	if idx < 0 {
+		return nil, 0, false
+	}
+	stream := bytes.TrimRight(data[start:start+idx], "\r\n")
+	return stream, start + idx + len("endstream"), true
+}
+
+// readObjectStream adds the objects stored in an object stream.
+func (d *pdfDocument) readObjectStream(num int, dict pdfDict) {
+	data, err := d.stream(num)
+	if err != nil {
+		return
+	}
+	n, _ := dict["N"].(float64)
+	first, _ := dict["First"].(float64)
+	if n <= 0 || first <= 0 || int(first) > len(data) {
+		return
+	}
+
+	header := &pdfParser{data: data[:int(first)]}
+	type entry struct{ num, offset int }
+	var entries []entry
+	for i := 0; i < int(n); i++ {
+		objNum, err1 := header.readObject()
+		offset, err2 := header.readObject()
+		numF, ok1 := objNum.(float64)
+		offF, ok2 := offset.(float64)
+		if err1 != nil || err2 != nil || !ok1 || !ok2 {
+			break
+		}
+		entries = append(entries, entry{int(numF), int(first) + int(offF)})
+	}
+
+	for _, e := range entries {
+		if _, ok := d.objects[e.num]; ok || e.offset < 0 || e.offset >= len(data) {
+			continue
+		}
+		p := &pdfParser{data: data, pos: e.offset}
+		value, err := p.readObject()
+		if err != nil {
+			continue
+		}
+		d.objects[e.num] = &pdfObject{value: value}
+		d.order = append(d.order, e.num)
+	}
+}
+
+// encrypted reports whether the document's trailer references an encryption
+// dictionary.
+func (d *pdfDocument) encrypted(data []byte) bool {
+	for _, num := range d.order {
+		if dict, ok := d.objects[num].value.(pdfDict); ok && dict["Type"] == pdfName("XRef") && dict["Encrypt"] != nil {
+			return true
+		}
+	}
+	for idx := 0; ; {
+		i := bytes.Index(data[idx:], []byte("trailer"))
+		if i < 0 {
+			return false
+		}
+		idx += i + len("trailer")
+		p := &pdfParser{data: data, pos: idx}
+		if dict, err := p.readObject(); err == nil {
+			if dict, ok := dict.(pdfDict); ok && dict["Encrypt"] != nil {
+				return true
+			}
+		}
+	}
+}
+
+// resolve follows references to the objects they point to.
+func (d *pdfDocument) resolve(v any) any {
+	for i := 0; i < 32; i++ {
+		ref, ok := v.(pdfRef)
+		if !ok {
+			return v
+		}
+		obj, ok := d.objects[ref.num]
+		if !ok {
+			return nil
+		}
+		v = obj.value
+	}
+	return nil
+}
+
+// dict resolves v and returns it if it's a dictionary.
+func (d *pdfDocument) dict(v any) pdfDict {
+	dict, _ := d.resolve(v).(pdfDict)
+	return dict
+}
+
+// stream returns the decoded stream of an object. Once the decoded streams of
+// the document reach maxPDFDecodedSize, no more streams are decoded.
+func (d *pdfDocument) stream(num int) ([]byte, error) {
+	if data, ok := d.decoded[num]; ok {
+		return data, nil
+	}
+	obj, ok := d.objects[num]
+	if !ok || obj.stream == nil {
+		return nil, fmt.Errorf("object %d has no stream", num)
+	}
+	remaining := maxPDFDecodedSize - d.decodedSize
+	if remaining <= 0 {
+		return nil, fmt.Errorf("decoded streams exceed %d bytes", maxPDFDecodedSize)
+	}
+	dict, _ := obj.value.(pdfDict)
+	data, err := d.decodeStream(obj.stream, dict, remaining)
+	if err != nil {
+		return nil, err
+	}
+	data = data[:min(len(data), remaining)]
+	d.decodedSize += len(data)
+	d.decoded[num] = data
+	return data, nil
+}
+
+// decodeStream applies a stream's filters, decompressing at most limit bytes.
+// Only the filters used for text and object streams are supported; streams
+// using others, such as images, can't be decoded.
+func (d *pdfDocument) decodeStream(data []byte, dict pdfDict, limit int) ([]byte, error) {
+	var filters []any
+	switch f := d.resolve(dict["Filter"]).(type) {
+	case pdfName:
+		filters = []any{f}
+	case pdfArray:
+		filters = f
+	}
+
+	for _, f := range filters {
+		var err error
+		switch d.resolve(f) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			data, err = inflatePDFStream(data, min(limit, maxPDFStreamSize))
+			if err == nil {
+				data, err = d.unpredict(data, dict)
+			}
+		case pdfName("ASCIIHexDecode"), pdfName("AHx"):
+			data = decodePDFHex(bytes.TrimSuffix(bytes.TrimSpace(data), []byte(">")))
+		case pdfName("ASCII85Decode"), pdfName("A85"):
+			data, err = decodePDFASCII85(data)
+		default:
+			return nil, fmt.Errorf("unsupported PDF filter %v", f)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// inflatePDFStream decompresses up to limit bytes of a FlateDecode stream.
+// Truncated streams are common, so whatever could be decompressed is returned.
+func inflatePDFStream(data []byte, limit int) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress stream: %w", err)
+	}
+	defer zr.Close()
+
+	out, err := io.ReadAll(io.LimitReader(zr, int64(limit)))
+	if err != nil && len(out) == 0 {
+		return nil, fmt.Errorf("unable to decompress stream: %w", err)
+	}
+	return out, nil
+}
+
+// unpredict reverses the PNG predictors that may be applied before
+// compression, as they usually are in cross-reference and object streams.
+func (d *pdfDocument) unpredict(data []byte, dict pdfDict) ([]byte, error) {
+	params := d.dict(dict["DecodeParms"])
+	if params == nil {
+		if arr, ok := d.resolve(dict["DecodeParms"]).(pdfArray); ok && len(arr) > 0 {
+			params = d.dict(arr[0])
+		}
+	}
+	predictor, _ := params["Predictor"].(float64)
+	if predictor < 10 {
+		return data, nil
+	}
+	columns := 1
+	if c, ok := params["Columns"].(float64); ok && c > 0 {
+		columns = int(c)
+	}
+
+	rowLen := columns + 1
+	var out []byte
+	prev := make([]byte, columns)
+	for i := 0; i+rowLen <= len(data); i += rowLen {
+		row := append([]byte(nil), data[i+1:i+rowLen]...)
+		for j := range row {
+			var left, upLeft byte
+			if j > 0 {
+				left, upLeft = row[j-1], prev[j-1]
+			}
+			switch data[i] {
+			case 1:
+				row[j] += left
+			case 2:
+				row[j] += prev[j]
+			case 3:
+				row[j] += byte((int(left) + int(prev[j])) / 2)
+			case 4:
+				row[j] += paeth(left, prev[j], upLeft)
+			}
+		}
+		out = append(out, row...)
+		prev = row
+	}
+	return out, nil
+}
+
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := abs(p-int(a)), abs(p-int(b)), abs(p-int(c))
+	switch {
+	case pa <= pb && pa <= pc:
+		return a
+	case pb <= pc:
+		return b
+	default:
+		return c
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+func decodePDFASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimSpace(data)
+	data = bytes.TrimPrefix(data, []byte("<~"))
+	if idx := bytes.Index(data, []byte("~>")); idx >= 0 {
+		data = data[:idx]
+	}
+	out := make([]byte, 4*len(data)/5+4)
+	n, _, err := ascii85.Decode(out, data, true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode ASCII85 stream: %w", err)
+	}
+	return out[:n], nil
+}
+
+// pdfPage is a page of a document, with the resources it inherits.
+type pdfPage struct {
+	dict      pdfDict
+	resources pdfDict
+}
+
+// pages returns the pages of the document in order, by walking its page
+// tree. Documents without a usable page tree return their page objects in
+// the order they appear in the file.
+func (d *pdfDocument) pages() []pdfPage {
+	var pages []pdfPage
+	visited := make(map[int]bool)
+	var walk func(node any, resources pdfDict)
+	walk = func(node any, resources pdfDict) {
+		if ref, ok := node.(pdfRef); ok {
+			if visited[ref.num] {
+				return
+			}
+			visited[ref.num] = true
+		}
+		dict := d.dict(node)
+		if dict == nil {
+			return
+		}
+		if res := d.dict(dict["Resources"]); res != nil {
+			resources = res
+		}
+		if kids, ok := d.resolve(dict["Kids"]).(pdfArray); ok {
+			for _, kid := range kids {
+				walk(kid, resources)
+			}
+			return
+		}
+		if dict["Type"] == pdfName("Page") || dict["Contents"] != nil {
+			pages = append(pages, pdfPage{dict: dict, resources: resources})
+		}
+	}
+
+	for _, num := range d.order {
+		if dict, ok := d.objects[num].value.(pdfDict); ok && dict["Type"] == pdfName("Catalog") {
+			walk(dict["Pages"], nil)
+			if len(pages) > 0 {
+				return pages
+			}
+		}
+	}
+
+	for _, num := range d.order {
+		if dict, ok := d.objects[num].value.(pdfDict); ok && dict["Type"] == pdfName("Page") {
+			pages = append(pages, pdfPage{dict: dict, resources: d.dict(dict["Resources"])})
+		}
+	}
+	return pages
+}
+
+// pageText returns the text of a page, followed by the targets of its links
+// and the contents of its annotations.
+func (d *pdfDocument) pageText(page pdfPage) []byte {
+	var buf bytes.Buffer
+
+	var contents []any
+	switch c := page.dict["Contents"].(type) {
+	case pdfRef:
+		if arr, ok := d.resolve(c).(pdfArray); ok {
+			contents = arr
+		} else {
+			contents = []any{c}
+		}
+	case pdfArray:
+		contents = c
+	}
+	// A page's content streams are concatenated before they're
+	// interpreted, since operators may span them.
+	var content []byte
+	for _, ref := range contents {
+		if ref, ok := ref.(pdfRef); ok {
+			if data, err := d.stream(ref.num); err == nil {
+				content = append(content, data...)
+				content = append(content, '\n')
+			}
+		}
+	}
+	d.contentText(&buf, content, page.resources, 0)
+
+	annots, _ := d.resolve(page.dict["Annots"]).(pdfArray)
+	for _, annot := range annots {
+		dict := d.dict(annot)
+		if action := d.dict(dict["A"]); action != nil {
+			if uri, ok := d.resolve(action["URI"]).(pdfString); ok {
+				buf.WriteByte('\n')
+				buf.Write(decodePDFTextString(uri))
+			}
+		}
+		if contents, ok := d.resolve(dict["Contents"]).(pdfString); ok {
+			buf.WriteByte('\n')
+			buf.Write(decodePDFTextString(contents))
+		}
+	}
+	return buf.Bytes()
+}
+
+// contentText interprets the text operators of a content stream. Text is
+// separated by newlines when it moves to a new line, and by spaces where
+// there are large gaps between glyphs.
+func (d *pdfDocument) contentText(buf *bytes.Buffer, content []byte, resources pdfDict, depth int) {
+	var (
+		p        = &pdfParser{data: content}
+		operands []any
+		font     *pdfFont
+		lastY    float64
+		haveY    bool
+	)
+	newline := func() {
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+	}
+	show := func(s pdfString) { buf.Write(font.decode(s)) }
+
+	for {
+		obj, err := p.readObject()
+		if err != nil {
+			return
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+
+		switch op {
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[len(operands)-2].(pdfName); ok {
+					font = d.font(resources, name)
+				}
+			}
+		case "Tj":
+			if s, ok := lastOperand[pdfString](operands); ok {
+				show(s)
+			}
+		case "'":
+			newline()
+			if s, ok := lastOperand[pdfString](operands); ok {
+				show(s)
+			}
+		case "\"":
+			newline()
+			if s, ok := lastOperand[pdfString](operands); ok {
+				show(s)
+			}
+		case "TJ":
+			arr, _ := lastOperand[pdfArray](operands)
+			for _, item := range arr {
+				switch v := item.(type) {
+				case pdfString:
+					show(v)
+				case float64:
+					// Offsets are in thousandths of a unit of text space;
+					// large negative ones move the next glyph to the right.
+					if v < -250 && !bytes.HasSuffix(buf.Bytes(), []byte(" ")) {
+						buf.WriteByte(' ')
+					}
+				}
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				if ty, ok := operands[len(operands)-1].(float64); ok && ty != 0 {
+					newline()
+				}
+			}
+		case "T*":
+			newline()
+		case "Tm":
+			if len(operands) >= 6 {
+				if y, ok := operands[len(operands)-1].(float64); ok {
+					if haveY && y != lastY {
+						newline()
+					}
+					lastY, haveY = y, true
+				}
+			}
+		case "Do":
+			if name, ok := lastOperand[pdfName](operands); ok && depth < maxPDFFormDepth {
+				d.formText(buf, resources, name, depth)
+			}
+		case "ID":
+			// Skip the binary data of inline images.
+			if idx := bytes.Index(content[p.pos:], []byte("EI")); idx >= 0 {
+				p.pos += idx + len("EI")
+			} else {
+				return
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+// formText interprets the content of a form XObject, which pages use to
+// reuse content such as headers.
+func (d *pdfDocument) formText(buf *bytes.Buffer, resources pdfDict, name pdfName, depth int) {
+	ref, ok := d.dict(resources["XObject"])[name].(pdfRef)
+	if !ok {
+		return
+	}
+	obj, ok := d.objects[ref.num]
+	if !ok {
+		return
+	}
+	dict, _ := obj.value.(pdfDict)
+	if dict["Subtype"] != pdfName("Form") {
+		return
+	}
+	content, err := d.stream(ref.num)
+	if err != nil {
+		return
+	}
+	if res := d.dict(dict["Resources"]); res != nil {
+		resources = res
+	}
+	d.contentText(buf, content, resources, depth+1)
+	buf.WriteByte('\n')
+}
+
+// lastOperand returns the last operand of an operator if it has type T.
+func lastOperand[T any](operands []any) (T, bool) {
+	var zero T
+	if len(operands) == 0 {
+		return zero, false
+	}
+	v, ok := operands[len(operands)-1].(T)
+	return v, ok
+}
+
+// pdfFont maps the character codes of strings shown with a font to text.
+type pdfFont struct {
+	// codeLen is the number of bytes per character code.
+	codeLen int
+	// toUnicode maps character codes to text, from the font's ToUnicode CMap.
+	toUnicode map[uint32]string
+}
+
+// font returns the text decoder of the named font in resources.
+func (d *pdfDocument) font(resources pdfDict, name pdfName) *pdfFont {
+	ref, ok := d.dict(resources["Font"])[name].(pdfRef)
+	if !ok {
+		return nil
+	}
+	if f, ok := d.fonts[ref.num]; ok {
+		return f
+	}
+
+	dict := d.dict(ref)
+	f := &pdfFont{codeLen: 1}
+	if dict["Subtype"] == pdfName("Type0") {
+		f.codeLen = 2
+	}
+	if cmapRef, ok := dict["ToUnicode"].(pdfRef); ok {
+		if data, err := d.stream(cmapRef.num); err == nil {
+			f.parseCMap(data)
+		}
+	}
+	d.fonts[ref.num] = f
+	return f
+}
+
+// maxCMapRange limits the number of codes a single CMap range can map.
+const maxCMapRange = 1 << 16
+
+// parseCMap reads the mappings of a ToUnicode CMap.
+func (f *pdfFont) parseCMap(data []byte) {
+	f.toUnicode = make(map[uint32]string)
+	var (
+		p        = &pdfParser{data: data}
+		operands []any
+	)
+	for {
+		obj, err := p.readObject()
+		if err != nil {
+			return
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+
+		switch op {
+		case "endcodespacerange":
+			if lo, ok := firstOperand[pdfString](operands); ok && len(lo) > 0 {
+				f.codeLen = len(lo)
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					f.toUnicode[pdfCode(src)] = string(decodeUTF16BE(dst))
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 {
+					continue
+				}
+				start, end := pdfCode(lo), pdfCode(hi)
+				if end < start || end-start >= maxCMapRange {
+					continue
+				}
+				switch dst := operands[i+2].(type) {
+				case pdfString:
+					runes := []rune(string(decodeUTF16BE(dst)))
+					if len(runes) == 0 {
+						continue
+					}
+					for code := start; code <= end; code++ {
+						f.toUnicode[code] = string(runes)
+						runes[len(runes)-1]++
+					}
+				case pdfArray:
+					for j, item := range dst {
+						if s, ok := item.(pdfString); ok && start+uint32(j) <= end {
+							f.toUnicode[start+uint32(j)] = string(decodeUTF16BE(s))
+						}
+					}
+				}
+			}
+		}
+		operands = operands[:0]
+	}
+}
+
+// firstOperand returns the first operand of an operator if it has type T.
+func firstOperand[T any](operands []any) (T, bool) {
+	var zero T
+	if len(operands) == 0 {
+		return zero, false
+	}
+	v, ok := operands[0].(T)
+	return v, ok
+}
+
+// pdfCode returns the character code a CMap string stands for.
+func pdfCode(s pdfString) uint32 {
+	var code uint32
+	for _, b := range s[:min(len(s), 4)] {
+		code = code<<8 | uint32(b)
+	}
+	return code
+}
+
+// decode maps the character codes of a shown string to text. Without a
+// ToUnicode CMap, single byte codes are assumed to be Latin-1, which covers
+// the standard encodings for ASCII text; multi-byte codes of composite fonts
+// are glyph IDs that can't be mapped and are left out.
+func (f *pdfFont) decode(s pdfString) []byte {
+	if f == nil {
+		return latin1ToUTF8(s)
+	}
+	if f.toUnicode == nil {
+		if f.codeLen == 1 {
+			return latin1ToUTF8(s)
+		}
+		return nil
+	}
+
+	var out []byte
+	for i := 0; i < len(s); i += f.codeLen {
+		code := pdfCode(s[i:min(i+f.codeLen, len(s))])
+		if text, ok := f.toUnicode[code]; ok {
+			out = append(out, text...)
+		} else if f.codeLen == 1 {
+			out = utf8.AppendRune(out, rune(code))
+		}
+	}
+	return out
+}
+
+func latin1ToUTF8(s []byte) []byte {
+	out := make([]byte, 0, len(s))
+	for _, b := range s {
+		out = utf8.AppendRune(out, rune(b))
+	}
+	return out
+}
+
+// decodePDFTextString decodes a string outside of content streams, such as a
+// link target, which is either UTF-16BE with a byte order mark or
+// PDFDocEncoding, which matches Latin-1 for printable ASCII.
+func decodePDFTextString(s pdfString) []byte {
+	if bytes.HasPrefix(s, []byte{0xfe, 0xff}) {
+		return decodeUTF16BE(s[2:])
+	}
+	return latin1ToUTF8(s)
+}
+
+func decodeUTF16BE(b []byte) []byte {
+	units := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+	}
+	runes := utf16.Decode(units)
+	out := make([]byte, 0, len(runes))
+	for _, r := range runes {
+		if unicode.IsPrint(r) || unicode.IsSpace(r) {
+			out = utf8.AppendRune(out, r)
+		}
+	}
+	return out
+}
+
+// pdfParser reads PDF objects, such as the values of indirect objects and the
+// operands and operators of content streams.
+type pdfParser struct {
+	data []byte
+	pos  int
+}
+
+// maxPDFNesting limits how deeply arrays and dictionaries can be nested.
+const maxPDFNesting = 64
+
+func isPDFSpace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+// skipSpace skips whitespace and comments.
+func (p *pdfParser) skipSpace() {
+	for p.pos < len(p.data) {
+		switch c := p.data[p.pos]; {
+		case isPDFSpace(c):
+			p.pos++
+		case c == '%':
+			for p.pos < len(p.data) && p.data[p.pos] != '\n' && p.data[p.pos] != '\r' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// readObject reads the next object. Anything that isn't a name, number,
+// string, array or dictionary is returned as a keyword, including the
+// operators of content streams. It returns io.EOF at the end of the data.
+func (p *pdfParser) readObject() (any, error) {
+	return p.readNested(0)
+}
+
+func (p *pdfParser) readNested(depth int) (any, error) {
+	if depth > maxPDFNesting {
+		return nil, errors.New("PDF objects are nested too deeply")
+	}
+	p.skipSpace()
+	if p.pos >= len(p.data) {
+		return nil, io.EOF
+	}
+
+	switch c := p.data[p.pos]; {
+	case c == '/':
+		p.pos++
+		return pdfName(decodePDFName(p.readRegular())), nil
+	case c == '(':
+		p.pos++
+		return p.readLiteralString(), nil
+	case c == '<' && p.pos+1 < len(p.data) && p.data[p.pos+1] == '<':
+		p.pos += 2
+		return p.readDict(depth)
+	case c == '<':
+		p.pos++
+		end := bytes.IndexByte(p.data[p.pos:], '>')
+		if end < 0 {
+			end = len(p.data) - p.pos
+		}
+		s := decodePDFHex(p.data[p.pos : p.pos+end])
+		p.pos = min(p.pos+end+1, len(p.data))
+		return pdfString(s), nil
+	case c == '[':
+		p.pos++
+		var arr pdfArray
+		for {
+			p.skipSpace()
+			if p.pos >= len(p.data) {
+				return arr, nil
+			}
+			if p.data[p.pos] == ']' {
+				p.pos++
+				return arr, nil
+			}
+			v, err := p.readNested(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+	case c == '+' || c == '-' || c == '.' || (c >= '0' && c <= '9'):
+		return p.readNumberOrRef(), nil
+	case isPDFDelimiter(c):
+		// Stray delimiters, e.g. the braces of PostScript functions.
+		p.pos++
+		return pdfKeyword(c), nil
+	default:
+		switch kw := p.readRegular(); kw {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return pdfKeyword(kw), nil
+		}
+	}
+}
+
+func (p *pdfParser) readDict(depth int) (pdfDict, error) {
+	dict := make(pdfDict)
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.data) {
+			return dict, nil
+		}
+		if bytes.HasPrefix(p.data[p.pos:], []byte(">>")) {
+			p.pos += 2
+			return dict, nil
+		}
+		key, err := p.readNested(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(pdfName)
+		if !ok {
+			// Malformed dictionaries are common; skip the stray token.
+			continue
+		}
+		value, err := p.readNested(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		dict[name] = value
+	}
+}
+
+// readRegular reads a run of regular characters.
+func (p *pdfParser) readRegular() string {
+	start := p.pos
+	for p.pos < len(p.data) && !isPDFSpace(p.data[p.pos]) && !isPDFDelimiter(p.data[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start && p.pos < len(p.data) {
+		p.pos++
+	}
+	return string(p.data[start:p.pos])
+}
+
+// readNumberOrRef reads a number, or a reference of the form "12 0 R".
+func (p *pdfParser) readNumberOrRef() any {
+	tok := p.readRegular()
+	n, err := strconv.ParseFloat(tok, 64)
+	if err != nil {
+		return pdfKeyword(tok)
+	}
+
+	if n == float64(int(n)) && n >= 0 {
+		save := p.pos
+		p.skipSpace()
+		gen := p.readRegular()
+		if _, err := strconv.Atoi(gen); err == nil {
+			p.skipSpace()
+			if p.pos < len(p.data) && p.data[p.pos] == 'R' &&
+				(p.pos+1 == len(p.data) || isPDFSpace(p.data[p.pos+1]) || isPDFDelimiter(p.data[p.pos+1])) {
+				p.pos++
+				return pdfRef{num: int(n)}
+			}
+		}
+		p.pos = save
+	}
+	return n
+}
+
+// readLiteralString reads a string in parentheses, whose opening parenthesis
+// has already been read.
+func (p *pdfParser) readLiteralString() pdfString {
+	var (
+		out   []byte
+		depth = 1
+	)
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		p.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+		case '\\':
+			if p.pos >= len(p.data) {
+				return out
+			}
+			e := p.data[p.pos]
+			p.pos++
+			switch e {
+			case 'n':
+				out = append(out, '\n')
+			case 'r':
+				out = append(out, '\r')
+			case 't':
+				out = append(out, '\t')
+			case 'b':
+				out = append(out, '\b')
+			case 'f':
+				out = append(out, '\f')
+			case '\r':
+				// A backslash at the end of a line continues the string.
+				if p.pos < len(p.data) && p.data[p.pos] == '\n' {
+					p.pos++
+				}
+			case '\n':
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && p.pos < len(p.data) && p.data[p.pos] >= '0' && p.data[p.pos] <= '7'; i++ {
+						v = v*8 + int(p.data[p.pos]-'0')
+						p.pos++
+					}
+					out = append(out, byte(v))
+				} else {
+					out = append(out, e)
+				}
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// decodePDFHex decodes a hex string, ignoring whitespace. A missing final
+// digit is taken to be 0.
+func decodePDFHex(data []byte) []byte {
+	digits := make([]byte, 0, len(data)+1)
+	for _, c := range data {
+		if isHexDigit(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out := make([]byte, len(digits)/2)
+	_, _ = hex.Decode(out, digits)
+	return out
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// decodePDFName decodes the #xx escapes of a name.
+func decodePDFName(s string) string {
+	if !strings.Contains(s, "#") {
+		return s
+	}
+	var out []byte
+	for i := 0; i < len(s); i++ {
+		if s[i] == '#' && i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]) {
+			b, _ := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			out = append(out, byte(b))
+			i += 2
+			continue
+		}
+		out = append(out, s[i])
+	}
+	return string(out)
+}
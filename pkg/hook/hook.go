@@ -0,0 +1,250 @@
+// Package hook runs TruffleHog as a git pre-commit, pre-push or pre-receive
+// hook. It works out which changes a hook invocation should scan from the
+// repository and the ref updates git passes on stdin, and installs the hook
+// scripts that invoke it.
+package hook
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// Type is a git hook that TruffleHog can run as.
+type Type string
+
+const (
+	// PreCommit scans the changes staged for a commit.
+	PreCommit Type = "pre-commit"
+	// PrePush scans the commits a push would send to the remote.
+	PrePush Type = "pre-push"
+	// PreReceive scans the commits a push would add to a (usually bare)
+	// server-side repository.
+	PreReceive Type = "pre-receive"
+)
+
+// Types lists the supported hook types.
+var Types = []string{string(PreCommit), string(PrePush), string(PreReceive)}
+
+// RefUpdate is a ref a push changes from Old to New. Either may be the zero
+// object ID, for refs the push creates or deletes.
+type RefUpdate struct {
+	Ref string
+	Old string
+	New string
+}
+
+// Target is what a hook invocation scans.
+type Target struct {
+	// Path is the absolute path of the repository: its top-level directory
+	// or, for bare repositories, its git directory.
+	Path string
+	// Bare reports whether the repository has no working tree.
+	Bare bool
+	// Staged selects the changes staged in the index.
+	Staged bool
+	// Revisions are the `git log` revision arguments that select the pushed
+	// commits.
+	Revisions []string
+}
+
+// Empty reports whether there is nothing to scan, for example because a push
+// only deletes refs.
+func (t Target) Empty() bool { return !t.Staged && len(t.Revisions) == 0 }
+
+// NewTarget works out what a hook of the given type should scan in the
+// repository at repoPath. Pre-push and pre-receive hooks read their ref
+// updates from stdin.
+func NewTarget(ctx context.Context, typ Type, repoPath string, stdin io.Reader) (Target, error) {
+	bare, err := gitOutput(ctx, repoPath, "rev-parse", "--is-bare-repository")
+	if err != nil {
+		return Target{}, err
+	}
+	target := Target{Bare: bare == "true"}
+
+	root := []string{"rev-parse", "--show-toplevel"}
+	if target.Bare {
+		root = []string{"rev-parse", "--absolute-git-dir"}
+	}
+	if target.Path, err = gitOutput(ctx, repoPath, root...); err != nil {
+		return Target{}, err
+	}
+	target.Path = filepath.Clean(target.Path)
+
+	switch typ {
+	case PreCommit:
+		if target.Bare {
+			return Target{}, fmt.Errorf("%s hooks need a repository with a working tree", typ)
+		}
+		target.Staged = true
+	case PrePush:
+		updates, err := ReadPrePush(stdin)
+		if err != nil {
+			return Target{}, err
+		}
+		// The remote ref may point at commits this repository has never
+		// fetched, e.g. when force pushing over someone else's work. Those
+		// can't be excluded, so fall back to excluding everything already
+		// on a remote, as for a new branch.
+		for i, u := range updates {
+			if !IsZero(u.Old) && !hasCommit(ctx, target.Path, u.Old) {
+				updates[i].Old = ""
+			}
+		}
+		target.Revisions = Revisions(updates, "--remotes")
+	case PreReceive:
+		updates, err := ReadPreReceive(stdin)
+		if err != nil {
+			return Target{}, err
+		}
+		// Refs are only updated once the hook succeeds, so --all excludes
+		// every commit the repository had before the push.
+		target.Revisions = Revisions(updates, "--all")
+	default:
+		return Target{}, fmt.Errorf("unsupported hook type %q", typ)
+	}
+	return target, nil
+}
+
+// ReadPreReceive parses the input of a pre-receive hook: one
+// "<old-oid> <new-oid> <ref-name>" line per updated ref.
+func ReadPreReceive(r io.Reader) ([]RefUpdate, error) {
+	return readUpdates(r, PreReceive, 3, func(f []string) RefUpdate {
+		return RefUpdate{Ref: f[2], Old: f[0], New: f[1]}
+	})
+}
+
+// ReadPrePush parses the input of a pre-push hook: one
+// "<local-ref> <local-oid> <remote-ref> <remote-oid>" line per pushed ref.
+// The returned updates are named after the remote ref.
+func ReadPrePush(r io.Reader) ([]RefUpdate, error) {
+	return readUpdates(r, PrePush, 4, func(f []string) RefUpdate {
+		return RefUpdate{Ref: f[2], Old: f[3], New: f[1]}
+	})
+}
+
+func readUpdates(r io.Reader, typ Type, fields int, parse func([]string) RefUpdate) ([]RefUpdate, error) {
+	var updates []RefUpdate
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		f := strings.Fields(line)
+		if len(f) != fields {
+			return nil, fmt.Errorf("malformed %s input on line %d: expected %d fields, got %d", typ, n, fields, len(f))
+		}
+		u := parse(f)
+		if !isObjectID(u.Old) || !isObjectID(u.New) {
+			return nil, fmt.Errorf("malformed %s input on line %d: invalid object ID", typ, n)
+		}
+		updates = append(updates, u)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading %s input: %w", typ, err)
+	}
+	return updates, nil
+}
+
+// Revisions returns the `git log` revision arguments that select the commits
+// introduced by updates: those reachable from a new value, but not from the
+// old value of any updated ref. If a ref is created, commits reachable from
+// exclude (e.g. "--all") are left out too, rather than scanning the entire
+// history of the new ref. Deleted refs introduce no commits. Revisions
+// returns nil if there is nothing to scan.
+func Revisions(updates []RefUpdate, exclude string) []string {
+	var (
+		heads, bases []string
+		created      bool
+		seen         = make(map[string]struct{})
+	)
+	add := func(list *[]string, rev string) {
+		if _, ok := seen[rev]; ok {
+			return
+		}
+		seen[rev] = struct{}{}
+		*list = append(*list, rev)
+	}
+
+	for _, u := range updates {
+		if IsZero(u.New) {
+			continue
+		}
+		add(&heads, u.New)
+		if IsZero(u.Old) {
+			created = true
+		} else {
+			add(&bases, "^"+u.Old)
+		}
+	}
+	if len(heads) == 0 {
+		return nil
+	}
+
+	revisions := append(heads, bases...)
+	if created {
+		revisions = append(revisions, "--not", exclude)
+	}
+	return revisions
+}
+
+// IsZero reports whether oid is empty or the all-zero object ID git uses for
+// refs that don't exist.
+func IsZero(oid string) bool { return strings.Trim(oid, "0") == "" }
+
+// isObjectID reports whether oid is a SHA-1 or SHA-256 object ID.
+func isObjectID(oid string) bool {
+	if len(oid) != 40 && len(oid) != 64 {
+		return false
+	}
+	_, err := hex.DecodeString(oid)
+	return err == nil
+}
+
+func hasCommit(ctx context.Context, repoPath, oid string) bool {
+	_, err := gitOutput(ctx, repoPath, "cat-file", "-e", oid+"^{commit}")
+	return err == nil
+}
+
+// gitOutput runs a git command in repoPath and returns its trimmed output.
+// It inherits the environment so that the GIT_* variables git sets for hooks,
+// such as the object quarantine of a push, are honored.
+func gitOutput(ctx context.Context, repoPath string, args ...string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", repoPath}, args...)...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s failed: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Summary returns the message printed after a hook has found secrets. It is
+// empty if nothing was found.
+func Summary(typ Type, found int) string {
+	if found == 0 {
+		return ""
+	}
+	noun, pronoun := "secrets", "them"
+	if found == 1 {
+		noun, pronoun = "secret", "it"
+	}
+
+	switch typ {
+	case PreCommit:
+		return fmt.Sprintf("trufflehog: %d %s found, commit blocked. Remove %s from the staged changes, or commit with --no-verify to bypass this check.", found, noun, pronoun)
+	case PrePush:
+		return fmt.Sprintf("trufflehog: %d %s found, push blocked. Remove %s from the pushed commits, or push with --no-verify to bypass this check.", found, noun, pronoun)
+	default:
+		return fmt.Sprintf("trufflehog: %d %s found, push rejected. Remove %s from the pushed commits and push again.", found, noun, pronoun)
+	}
+}
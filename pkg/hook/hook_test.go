@@ -0,0 +1,218 @@
+package hook
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	zero = "0000000000000000000000000000000000000000"
+	oidA = "1111111111111111111111111111111111111111"
+	oidB = "2222222222222222222222222222222222222222"
+	oidC = "3333333333333333333333333333333333333333"
+)
+
+func TestReadPreReceive(t *testing.T) {
+	input := strings.Join([]string{
+		oidA + " " + oidB + " refs/heads/main",
+		"",
+		zero + " " + oidC + " refs/heads/feature",
+		oidA + " " + zero + " refs/tags/v1",
+	}, "\n")
+
+	updates, err := ReadPreReceive(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []RefUpdate{
+		{Ref: "refs/heads/main", Old: oidA, New: oidB},
+		{Ref: "refs/heads/feature", Old: zero, New: oidC},
+		{Ref: "refs/tags/v1", Old: oidA, New: zero},
+	}, updates)
+}
+
+func TestReadPrePush(t *testing.T) {
+	input := "refs/heads/main " + oidB + " refs/heads/main " + oidA + "\n" +
+		"(delete) " + zero + " refs/heads/old " + oidC + "\n"
+
+	updates, err := ReadPrePush(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []RefUpdate{
+		{Ref: "refs/heads/main", Old: oidA, New: oidB},
+		{Ref: "refs/heads/old", Old: oidC, New: zero},
+	}, updates)
+}
+
+func TestReadUpdates_Malformed(t *testing.T) {
+	tests := map[string]string{
+		"too few fields":  oidA + " " + oidB,
+		"too many fields": oidA + " " + oidB + " refs/heads/main extra",
+		"short oid":       "1234 " + oidB + " refs/heads/main",
+		"not hex":         strings.Repeat("g", 40) + " " + oidB + " refs/heads/main",
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ReadPreReceive(strings.NewReader(input))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRevisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		updates []RefUpdate
+		want    []string
+	}{
+		{
+			name:    "update",
+			updates: []RefUpdate{{Old: oidA, New: oidB}},
+			want:    []string{oidB, "^" + oidA},
+		},
+		{
+			name:    "create",
+			updates: []RefUpdate{{Old: zero, New: oidB}},
+			want:    []string{oidB, "--not", "--all"},
+		},
+		{
+			name:    "delete",
+			updates: []RefUpdate{{Old: oidA, New: zero}},
+			want:    nil,
+		},
+		{
+			name: "several refs",
+			updates: []RefUpdate{
+				{Old: oidA, New: oidB},
+				{Old: zero, New: oidC},
+				{Old: oidA, New: oidC},
+				{Old: oidC, New: zero},
+			},
+			want: []string{oidB, oidC, "^" + oidA, "--not", "--all"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Revisions(tt.updates, "--all"))
+		})
+	}
+}
+
+// initRepo creates a repository with a single commit and returns its path
+// and the commit's ID.
+func initRepo(t *testing.T, args ...string) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=Test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=Test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git(append([]string{"init", "-q"}, args...)...)
+	tree := git("mktree")
+	commit := git("commit-tree", tree, "-m", "initial")
+	git("update-ref", "refs/heads/main", commit)
+	return dir, commit
+}
+
+func TestNewTarget(t *testing.T) {
+	ctx := context.Background()
+	repo, commit := initRepo(t)
+	bare, _ := initRepo(t, "--bare")
+
+	t.Run("pre-commit", func(t *testing.T) {
+		target, err := NewTarget(ctx, PreCommit, repo, nil)
+		require.NoError(t, err)
+		assert.Equal(t, Target{Path: evalSymlinks(t, repo), Staged: true}, target)
+	})
+
+	t.Run("pre-commit in a bare repository", func(t *testing.T) {
+		_, err := NewTarget(ctx, PreCommit, bare, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("pre-push to a ref with unknown commits", func(t *testing.T) {
+		input := "refs/heads/main " + commit + " refs/heads/main " + oidA + "\n"
+		target, err := NewTarget(ctx, PrePush, repo, strings.NewReader(input))
+		require.NoError(t, err)
+		assert.Equal(t, []string{commit, "--not", "--remotes"}, target.Revisions)
+	})
+
+	t.Run("pre-receive", func(t *testing.T) {
+		input := commit + " " + oidB + " refs/heads/main\n"
+		target, err := NewTarget(ctx, PreReceive, bare, strings.NewReader(input))
+		require.NoError(t, err)
+		assert.True(t, target.Bare)
+		assert.Equal(t, evalSymlinks(t, bare), target.Path)
+		assert.Equal(t, []string{oidB, "^" + commit}, target.Revisions)
+	})
+
+	t.Run("pre-receive deleting a ref", func(t *testing.T) {
+		input := commit + " " + zero + " refs/heads/main\n"
+		target, err := NewTarget(ctx, PreReceive, bare, strings.NewReader(input))
+		require.NoError(t, err)
+		assert.True(t, target.Empty())
+	})
+}
+
+func evalSymlinks(t *testing.T, path string) string {
+	t.Helper()
+	resolved, err := filepath.EvalSymlinks(path)
+	require.NoError(t, err)
+	return resolved
+}
+
+func TestInstall(t *testing.T) {
+	ctx := context.Background()
+	repo, _ := initRepo(t)
+	hookPath := filepath.Join(repo, ".git", "hooks", "pre-commit")
+
+	path, err := Install(ctx, repo, PreCommit, InstallOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, hookPath, path)
+
+	script, err := os.ReadFile(hookPath)
+	require.NoError(t, err)
+	assert.Equal(t, Script(PreCommit, "trufflehog"), string(script))
+	assert.Contains(t, string(script), `exec trufflehog --no-update hook run pre-commit "$@"`)
+	info, err := os.Stat(hookPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+
+	// Reinstalling replaces a hook TruffleHog installed.
+	_, err = Install(ctx, repo, PreCommit, InstallOptions{Command: "/usr/local/bin/trufflehog"})
+	require.NoError(t, err)
+
+	// Other hooks are only replaced with Force.
+	require.NoError(t, os.WriteFile(hookPath, []byte("#!/bin/sh\nmake lint\n"), 0o644))
+	_, err = Install(ctx, repo, PreCommit, InstallOptions{})
+	assert.ErrorContains(t, err, "already exists")
+	_, err = Install(ctx, repo, PreCommit, InstallOptions{Force: true})
+	require.NoError(t, err)
+	info, err = os.Stat(hookPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o755), info.Mode().Perm())
+}
+
+func TestInstall_Bare(t *testing.T) {
+	bare, _ := initRepo(t, "--bare")
+
+	path, err := Install(context.Background(), bare, PreReceive, InstallOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(bare, "hooks", "pre-receive"), path)
+}
+
+func TestSummary(t *testing.T) {
+	assert.Empty(t, Summary(PreCommit, 0))
+	assert.Contains(t, Summary(PreCommit, 1), "1 secret found, commit blocked")
+	assert.Contains(t, Summary(PrePush, 2), "2 secrets found, push blocked")
+	assert.Contains(t, Summary(PreReceive, 3), "3 secrets found, push rejected")
+}
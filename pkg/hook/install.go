@@ -0,0 +1,75 @@
+package hook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// marker identifies hook scripts written by Install, so that they can be
+// replaced without --force.
+const marker = "# Installed by `trufflehog hook install`."
+
+// InstallOptions configures Install.
+type InstallOptions struct {
+	// Command invokes TruffleHog, e.g. "trufflehog" or an absolute path.
+	Command string
+	// Force replaces an existing hook that wasn't installed by TruffleHog.
+	Force bool
+}
+
+// Install writes a hook script of the given type into the hooks directory of
+// the repository at repoPath and returns its path. The hooks directory is the
+// one git itself uses, so core.hooksPath and bare repositories are honored.
+func Install(ctx context.Context, repoPath string, typ Type, opts InstallOptions) (string, error) {
+	switch typ {
+	case PreCommit, PrePush, PreReceive:
+	default:
+		return "", fmt.Errorf("unsupported hook type %q", typ)
+	}
+	if opts.Command == "" {
+		opts.Command = "trufflehog"
+	}
+
+	hooksDir, err := gitOutput(ctx, repoPath, "rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(repoPath, hooksDir)
+	}
+	if err := os.MkdirAll(hooksDir, 0o755); err != nil {
+		return "", fmt.Errorf("unable to create hooks directory: %w", err)
+	}
+
+	hookPath := filepath.Join(hooksDir, string(typ))
+	existing, err := os.ReadFile(hookPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+	case err != nil:
+		return "", fmt.Errorf("unable to read existing hook: %w", err)
+	case !opts.Force && !bytes.Contains(existing, []byte(marker)):
+		return "", fmt.Errorf("a %s hook already exists at %s; use --force to replace it", typ, hookPath)
+	}
+
+	if err := os.WriteFile(hookPath, []byte(Script(typ, opts.Command)), 0o755); err != nil {
+		return "", fmt.Errorf("unable to write hook: %w", err)
+	}
+	// WriteFile keeps the mode of an existing file.
+	if err := os.Chmod(hookPath, 0o755); err != nil {
+		return "", fmt.Errorf("unable to make hook executable: %w", err)
+	}
+	return hookPath, nil
+}
+
+// Script returns the hook script Install writes. Automatic updates are
+// disabled because they would run on every commit or push. Git passes the
+// hook's arguments and stdin through to `trufflehog hook run`.
+func Script(typ Type, command string) string {
+	return fmt.Sprintf("#!/bin/sh\n%s\nexec %s --no-update hook run %s \"$@\"\n", marker, command, typ)
+}
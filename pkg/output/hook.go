@@ -0,0 +1,84 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+)
+
+// HookPrinter is a printer for git hooks. It prints one line per result,
+// prefixed with "trufflehog:" so that results stand out among the other
+// output of a commit or push, and never prints the secret itself, because
+// the output of server-side hooks is relayed to whoever pushed.
+type HookPrinter struct {
+	mu sync.Mutex
+	// Writer is where results are written. Defaults to os.Stderr, which git
+	// shows to the user for every hook type.
+	Writer io.Writer
+
+	found int
+}
+
+func (p *HookPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata) error {
+	status := "unverified"
+	switch {
+	case r.Verified:
+		status = "verified"
+	case r.VerificationError() != nil:
+		status = "unknown"
+	}
+
+	var location string
+	if git := r.SourceMetadata.GetGit(); git != nil {
+		location = hookLocation(git.GetFile(), git.GetLine(), git.GetCommit())
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	w := p.Writer
+	if w == nil {
+		w = os.Stderr
+	}
+	p.found++
+	_, err := fmt.Fprintf(w, "trufflehog: %s %s secret%s\n", status, r.DetectorType.String(), location)
+	return err
+}
+
+// Found returns the number of results printed so far.
+func (p *HookPrinter) Found() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.found
+}
+
+// hookLocation describes where in a repository a result was found. The git
+// source reports staged changes with the commit "Staged", and commit messages
+// without a file.
+func hookLocation(file string, line int64, commit string) string {
+	var loc string
+	switch {
+	case file != "" && line > 0:
+		loc = fmt.Sprintf(" at %s:%d", file, line)
+	case file != "":
+		loc = " at " + file
+	}
+
+	if len(commit) > 12 {
+		commit = commit[:12]
+	}
+	switch {
+	case commit == "":
+		return loc
+	case commit == "Staged":
+		return loc + " in staged changes"
+	case file == "":
+		return " in the message of commit " + commit
+	default:
+		return loc + " in commit " + commit
+	}
+}
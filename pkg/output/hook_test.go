@@ -0,0 +1,55 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+func TestHookPrinter(t *testing.T) {
+	gitMeta := func(file string, line int64, commit string) *source_metadatapb.MetaData {
+		return &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Git{
+				Git: &source_metadatapb.Git{File: file, Line: line, Commit: commit},
+			},
+		}
+	}
+
+	var buf bytes.Buffer
+	p := &HookPrinter{Writer: &buf}
+
+	results := []detectors.ResultWithMetadata{
+		{
+			SourceMetadata: gitMeta("config/prod.env", 12, "Staged"),
+			Result:         detectors.Result{DetectorType: detectorspb.DetectorType_AWS, Verified: true, Raw: []byte("AKIAEXAMPLE")},
+		},
+		{
+			SourceMetadata: gitMeta("main.go", 0, "0123456789abcdef0123456789abcdef01234567"),
+			Result:         detectors.Result{DetectorType: detectorspb.DetectorType_Github, Raw: []byte("ghp_example")},
+		},
+		{
+			SourceMetadata: gitMeta("", 0, "0123456789abcdef0123456789abcdef01234567"),
+			Result:         detectors.Result{DetectorType: detectorspb.DetectorType_Slack, Raw: []byte("xoxb-example")},
+		},
+	}
+	results[1].SetVerificationError(assert.AnError)
+
+	for i := range results {
+		require.NoError(t, p.Print(context.Background(), &results[i]))
+	}
+
+	assert.Equal(t, 3, p.Found())
+	assert.Equal(t,
+		"trufflehog: verified AWS secret at config/prod.env:12 in staged changes\n"+
+			"trufflehog: unknown Github secret at main.go in commit 0123456789ab\n"+
+			"trufflehog: unverified Slack secret in the message of commit 0123456789ab\n",
+		buf.String())
+	assert.NotContains(t, buf.String(), "example")
+}
@@ -40,7 +40,10 @@ func (p *JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		// DetectorDescription is the description of the Detector.
 		DetectorDescription string
 		// DecoderName is the string name of the DecoderType.
-		DecoderName           string
+		DecoderName string
+		// DecoderChain lists the names of the decoders that were applied on
+		// top of each other, for results found in nested encodings.
+		DecoderChain          []string `json:",omitempty"`
 		Verified              bool
 		VerificationError     string `json:",omitempty"`
 		VerificationFromCache bool
@@ -54,6 +57,8 @@ func (p *JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		Redacted       string
 		ExtraData      map[string]string
 		StructuredData *detectorspb.StructuredData
+		// Analysis summarizes the permissions of a verified credential.
+		Analysis *detectors.AnalysisSummary `json:",omitempty"`
 	}{
 		SourceMetadata:        r.SourceMetadata,
 		SourceID:              r.SourceID,
@@ -63,6 +68,7 @@ func (p *JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		DetectorName:          r.DetectorType.String(),
 		DetectorDescription:   r.DetectorDescription,
 		DecoderName:           r.DecoderType.String(),
+		DecoderChain:          decoderChainNames(r.DecoderChain),
 		Verified:              r.Verified,
 		VerificationError:     verificationErr,
 		VerificationFromCache: r.VerificationFromCache,
@@ -71,6 +77,7 @@ func (p *JSONPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 		Redacted:              r.Redacted,
 		ExtraData:             r.ExtraData,
 		StructuredData:        r.StructuredData,
+		Analysis:              r.Analysis,
 	}
 	out, err := json.Marshal(v)
 	if err != nil {
@@ -13,6 +13,7 @@ import (
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
 )
 
@@ -32,6 +33,7 @@ func (p *PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 	out := outputFormat{
 		DetectorType:        r.Result.DetectorType.String(),
 		DecoderType:         r.DecoderType.String(),
+		DecoderChain:        decoderChainNames(r.DecoderChain),
 		Verified:            r.Result.Verified,
 		VerificationError:   r.Result.VerificationError(),
 		MetaData:            r.SourceMetadata,
@@ -92,6 +94,9 @@ func (p *PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 	}
 	printer.Printf("Detector Type: %s\n", out.DetectorType)
 	printer.Printf("Decoder Type: %s\n", out.DecoderType)
+	if len(out.DecoderChain) > 0 {
+		printer.Printf("Decoder Chain: %s\n", strings.Join(out.DecoderChain, " -> "))
+	}
 	printer.Printf("Raw result: %s\n", whitePrinter.Sprint(out.Raw))
 
 	for k, v := range r.Result.ExtraData {
@@ -126,6 +131,10 @@ func (p *PlainPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata)
 			aggregateData[k] = v
 		}
 	}
+	if location := r.SourceMetadata.GetLocation(); location != "" {
+		aggregateDataKeys = append(aggregateDataKeys, "location")
+		aggregateData["location"] = location
+	}
 	sort.Strings(aggregateDataKeys)
 	for _, k := range aggregateDataKeys {
 		printer.Printf("%s: %v\n", cases.Title(language.AmericanEnglish).String(k), aggregateData[k])
@@ -159,9 +168,23 @@ func structToMap(obj any) (m map[string]map[string]any, err error) {
 	return
 }
 
+// decoderChainNames returns the names of the decoders in chain, or nil if at
+// most one decoder was applied, in which case DecoderType says it all.
+func decoderChainNames(chain []detectorspb.DecoderType) []string {
+	if len(chain) < 2 {
+		return nil
+	}
+	names := make([]string, len(chain))
+	for i, d := range chain {
+		names[i] = d.String()
+	}
+	return names
+}
+
 type outputFormat struct {
 	DetectorType,
 	DecoderType string
+	DecoderChain      []string
 	Verified          bool
 	VerificationError error
 	Raw               string
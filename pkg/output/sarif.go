@@ -0,0 +1,218 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/version"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// SARIFPrinter is a printer that collects results and writes them as a single
+// SARIF 2.1.0 log when closed. Unlike the other printers, nothing is written
+// until Close is called, because SARIF is a single JSON document rather than a
+// stream of records.
+type SARIFPrinter struct {
+	mu sync.Mutex
+	// Writer is where the SARIF log is written. Defaults to os.Stdout.
+	Writer io.Writer
+
+	rules   map[string]sarifRule
+	results []sarifResult
+}
+
+func (p *SARIFPrinter) Print(_ context.Context, r *detectors.ResultWithMetadata) error {
+	meta, err := structToMap(r.SourceMetadata.Data)
+	if err != nil {
+		return fmt.Errorf("could not marshal result: %w", err)
+	}
+
+	var (
+		file string
+		line int64
+	)
+	for _, data := range meta {
+		for k, v := range data {
+			if k == "line" {
+				if l, ok := v.(float64); ok {
+					line = int64(l)
+				}
+			}
+			if k == "file" {
+				if filename, ok := v.(string); ok {
+					file = filename
+				}
+			}
+		}
+	}
+
+	ruleID := r.DetectorType.String()
+	verifiedStatus := "unverified"
+	level := "warning"
+	if r.Verified {
+		verifiedStatus = "verified"
+		level = "error"
+	}
+
+	message := r.Redacted
+	if message == "" {
+		message = fmt.Sprintf("Found %s %s result", verifiedStatus, ruleID)
+	}
+
+	result := sarifResult{
+		RuleID:  ruleID,
+		Level:   level,
+		Message: sarifMessage{Text: message},
+		Properties: sarifResultProperties{
+			Verified:    r.Verified,
+			DecoderName: r.DecoderType.String(),
+			SourceName:  r.SourceName,
+			ExtraData:   r.ExtraData,
+		},
+	}
+	if verificationErr := r.VerificationError(); verificationErr != nil {
+		result.Properties.VerificationError = verificationErr.Error()
+	}
+	if file != "" {
+		loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: file}}
+		// SARIF lines are 1-based; a zero line means the source didn't provide one.
+		if line > 0 {
+			loc.Region = &sarifRegion{StartLine: line}
+		}
+		result.Locations = []sarifLocation{{PhysicalLocation: loc}}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.rules == nil {
+		p.rules = make(map[string]sarifRule)
+	}
+	if _, ok := p.rules[ruleID]; !ok {
+		rule := sarifRule{ID: ruleID, Name: ruleID}
+		if r.DetectorDescription != "" {
+			rule.ShortDescription = &sarifMessage{Text: r.DetectorDescription}
+		}
+		p.rules[ruleID] = rule
+	}
+	p.results = append(p.results, result)
+	return nil
+}
+
+// Close writes the collected results as a SARIF log. It must be called once
+// the engine has finished dispatching results.
+func (p *SARIFPrinter) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	rules := make([]sarifRule, 0, len(p.rules))
+	for _, rule := range p.rules {
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+
+	results := p.results
+	if results == nil {
+		// SARIF requires the results array to be present, even when empty.
+		results = []sarifResult{}
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "TruffleHog",
+				Version:        version.BuildVersion,
+				InformationURI: "https://github.com/trufflesecurity/trufflehog",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+
+	w := p.Writer
+	if w == nil {
+		w = os.Stdout
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(log); err != nil {
+		return fmt.Errorf("could not write SARIF log: %w", err)
+	}
+	return nil
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	Name             string        `json:"name,omitempty"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID     string                `json:"ruleId"`
+	Level      string                `json:"level"`
+	Message    sarifMessage          `json:"message"`
+	Locations  []sarifLocation       `json:"locations,omitempty"`
+	Properties sarifResultProperties `json:"properties"`
+}
+
+type sarifResultProperties struct {
+	Verified          bool              `json:"verified"`
+	VerificationError string            `json:"verificationError,omitempty"`
+	DecoderName       string            `json:"decoderName,omitempty"`
+	SourceName        string            `json:"sourceName,omitempty"`
+	ExtraData         map[string]string `json:"extraData,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int64 `json:"startLine"`
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/detectors"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/detectorspb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+func TestSARIFPrinter(t *testing.T) {
+	var buf bytes.Buffer
+	p := &SARIFPrinter{Writer: &buf}
+
+	results := []detectors.ResultWithMetadata{
+		{
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Filesystem{
+					Filesystem: &source_metadatapb.Filesystem{File: "config/.env", Line: 12},
+				},
+			},
+			SourceName: "trufflehog - filesystem",
+			Result: detectors.Result{
+				DetectorType: detectorspb.DetectorType_AWS,
+				Verified:     true,
+				Redacted:     "AKIAEXAMPLE",
+			},
+			DetectorDescription: "AWS credentials",
+		},
+		{
+			SourceMetadata: &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Filesystem{
+					Filesystem: &source_metadatapb.Filesystem{File: "main.go"},
+				},
+			},
+			Result: detectors.Result{DetectorType: detectorspb.DetectorType_Github},
+		},
+	}
+	for i := range results {
+		require.NoError(t, p.Print(context.Background(), &results[i]))
+	}
+	require.NoError(t, p.Close())
+
+	var got sarifLog
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	assert.Equal(t, sarifVersion, got.Version)
+	require.Len(t, got.Runs, 1)
+
+	run := got.Runs[0]
+	require.Len(t, run.Tool.Driver.Rules, 2)
+	assert.Equal(t, "AWS", run.Tool.Driver.Rules[0].ID)
+	assert.Equal(t, "Github", run.Tool.Driver.Rules[1].ID)
+
+	require.Len(t, run.Results, 2)
+	aws := run.Results[0]
+	assert.Equal(t, "AWS", aws.RuleID)
+	assert.Equal(t, "error", aws.Level)
+	assert.Equal(t, "AKIAEXAMPLE", aws.Message.Text)
+	assert.True(t, aws.Properties.Verified)
+	require.Len(t, aws.Locations, 1)
+	assert.Equal(t, "config/.env", aws.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.NotNil(t, aws.Locations[0].PhysicalLocation.Region)
+	assert.Equal(t, int64(12), aws.Locations[0].PhysicalLocation.Region.StartLine)
+
+	gh := run.Results[1]
+	assert.Equal(t, "warning", gh.Level)
+	assert.Equal(t, "Found unverified Github result", gh.Message.Text)
+	assert.False(t, gh.Properties.Verified)
+	require.Len(t, gh.Locations, 1)
+	assert.Nil(t, gh.Locations[0].PhysicalLocation.Region)
+}
+
+func TestSARIFPrinter_NoResults(t *testing.T) {
+	var buf bytes.Buffer
+	p := &SARIFPrinter{Writer: &buf}
+	require.NoError(t, p.Close())
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	runs := got["runs"].([]any)
+	require.Len(t, runs, 1)
+	assert.Equal(t, []any{}, runs[0].(map[string]any)["results"])
+}
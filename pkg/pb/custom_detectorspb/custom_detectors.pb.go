@@ -21,6 +21,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type CustomRegex_CleaningPolicy int32
+
+const (
+	CustomRegex_DEFAULT CustomRegex_CleaningPolicy = 0
+	CustomRegex_ALWAYS  CustomRegex_CleaningPolicy = 1
+	CustomRegex_NEVER   CustomRegex_CleaningPolicy = 2
+)
+
+// Enum value maps for CustomRegex_CleaningPolicy.
+var (
+	CustomRegex_CleaningPolicy_name = map[int32]string{
+		0: "DEFAULT",
+		1: "ALWAYS",
+		2: "NEVER",
+	}
+	CustomRegex_CleaningPolicy_value = map[string]int32{
+		"DEFAULT": 0,
+		"ALWAYS":  1,
+		"NEVER":   2,
+	}
+)
+
+func (x CustomRegex_CleaningPolicy) Enum() *CustomRegex_CleaningPolicy {
+	p := new(CustomRegex_CleaningPolicy)
+	*p = x
+	return p
+}
+
+func (x CustomRegex_CleaningPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomRegex_CleaningPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_custom_detectors_proto_enumTypes[0].Descriptor()
+}
+
+func (CustomRegex_CleaningPolicy) Type() protoreflect.EnumType {
+	return &file_custom_detectors_proto_enumTypes[0]
+}
+
+func (x CustomRegex_CleaningPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomRegex_CleaningPolicy.Descriptor instead.
+func (CustomRegex_CleaningPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_custom_detectors_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type ResponseMatcher_Result int32
+
+const (
+	ResponseMatcher_RESULT_UNSPECIFIED ResponseMatcher_Result = 0
+	ResponseMatcher_VERIFIED           ResponseMatcher_Result = 1
+	ResponseMatcher_UNVERIFIED         ResponseMatcher_Result = 2
+	ResponseMatcher_UNKNOWN            ResponseMatcher_Result = 3
+)
+
+// Enum value maps for ResponseMatcher_Result.
+var (
+	ResponseMatcher_Result_name = map[int32]string{
+		0: "RESULT_UNSPECIFIED",
+		1: "VERIFIED",
+		2: "UNVERIFIED",
+		3: "UNKNOWN",
+	}
+	ResponseMatcher_Result_value = map[string]int32{
+		"RESULT_UNSPECIFIED": 0,
+		"VERIFIED":           1,
+		"UNVERIFIED":         2,
+		"UNKNOWN":            3,
+	}
+)
+
+func (x ResponseMatcher_Result) Enum() *ResponseMatcher_Result {
+	p := new(ResponseMatcher_Result)
+	*p = x
+	return p
+}
+
+func (x ResponseMatcher_Result) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResponseMatcher_Result) Descriptor() protoreflect.EnumDescriptor {
+	return file_custom_detectors_proto_enumTypes[1].Descriptor()
+}
+
+func (ResponseMatcher_Result) Type() protoreflect.EnumType {
+	return &file_custom_detectors_proto_enumTypes[1]
+}
+
+func (x ResponseMatcher_Result) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResponseMatcher_Result.Descriptor instead.
+func (ResponseMatcher_Result) EnumDescriptor() ([]byte, []int) {
+	return file_custom_detectors_proto_rawDescGZIP(), []int{3, 0}
+}
+
 type CustomDetectors struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -73,16 +174,21 @@ type CustomRegex struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                  string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Keywords              []string          `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`
-	Regex                 map[string]string `protobuf:"bytes,3,rep,name=regex,proto3" json:"regex,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Verify                []*VerifierConfig `protobuf:"bytes,4,rep,name=verify,proto3" json:"verify,omitempty"`
-	Description           string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	ExcludeRegexesCapture []string          `protobuf:"bytes,6,rep,name=exclude_regexes_capture,json=excludeRegexesCapture,proto3" json:"exclude_regexes_capture,omitempty"`
-	ExcludeWords          []string          `protobuf:"bytes,7,rep,name=exclude_words,json=excludeWords,proto3" json:"exclude_words,omitempty"`
-	Entropy               float32           `protobuf:"fixed32,8,opt,name=entropy,proto3" json:"entropy,omitempty"`
-	ExcludeRegexesMatch   []string          `protobuf:"bytes,9,rep,name=exclude_regexes_match,json=excludeRegexesMatch,proto3" json:"exclude_regexes_match,omitempty"`
-	PrimaryRegexName      string            `protobuf:"bytes,10,opt,name=primary_regex_name,json=primaryRegexName,proto3" json:"primary_regex_name,omitempty"`
+	Name                  string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Keywords              []string                   `protobuf:"bytes,2,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Regex                 map[string]string          `protobuf:"bytes,3,rep,name=regex,proto3" json:"regex,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Verify                []*VerifierConfig          `protobuf:"bytes,4,rep,name=verify,proto3" json:"verify,omitempty"`
+	Description           string                     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	ExcludeRegexesCapture []string                   `protobuf:"bytes,6,rep,name=exclude_regexes_capture,json=excludeRegexesCapture,proto3" json:"exclude_regexes_capture,omitempty"`
+	ExcludeWords          []string                   `protobuf:"bytes,7,rep,name=exclude_words,json=excludeWords,proto3" json:"exclude_words,omitempty"`
+	Entropy               float32                    `protobuf:"fixed32,8,opt,name=entropy,proto3" json:"entropy,omitempty"`
+	ExcludeRegexesMatch   []string                   `protobuf:"bytes,9,rep,name=exclude_regexes_match,json=excludeRegexesMatch,proto3" json:"exclude_regexes_match,omitempty"`
+	PrimaryRegexName      string                     `protobuf:"bytes,10,opt,name=primary_regex_name,json=primaryRegexName,proto3" json:"primary_regex_name,omitempty"`
+	ShouldMatch           []string                   `protobuf:"bytes,11,rep,name=should_match,json=shouldMatch,proto3" json:"should_match,omitempty"`
+	ShouldNotMatch        []string                   `protobuf:"bytes,12,rep,name=should_not_match,json=shouldNotMatch,proto3" json:"should_not_match,omitempty"`
+	MaxCredentialSpan     int64                      `protobuf:"varint,13,opt,name=max_credential_span,json=maxCredentialSpan,proto3" json:"max_credential_span,omitempty"`
+	FalsePositives        []string                   `protobuf:"bytes,14,rep,name=false_positives,json=falsePositives,proto3" json:"false_positives,omitempty"`
+	CleaningPolicy        CustomRegex_CleaningPolicy `protobuf:"varint,15,opt,name=cleaning_policy,json=cleaningPolicy,proto3,enum=custom_detectors.CustomRegex_CleaningPolicy" json:"cleaning_policy,omitempty"`
 }
 
 func (x *CustomRegex) Reset() {
@@ -187,15 +293,53 @@ func (x *CustomRegex) GetPrimaryRegexName() string {
 	return ""
 }
 
+func (x *CustomRegex) GetShouldMatch() []string {
+	if x != nil {
+		return x.ShouldMatch
+	}
+	return nil
+}
+
+func (x *CustomRegex) GetShouldNotMatch() []string {
+	if x != nil {
+		return x.ShouldNotMatch
+	}
+	return nil
+}
+
+func (x *CustomRegex) GetMaxCredentialSpan() int64 {
+	if x != nil {
+		return x.MaxCredentialSpan
+	}
+	return 0
+}
+
+func (x *CustomRegex) GetFalsePositives() []string {
+	if x != nil {
+		return x.FalsePositives
+	}
+	return nil
+}
+
+func (x *CustomRegex) GetCleaningPolicy() CustomRegex_CleaningPolicy {
+	if x != nil {
+		return x.CleaningPolicy
+	}
+	return CustomRegex_DEFAULT
+}
+
 type VerifierConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Endpoint      string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Unsafe        bool     `protobuf:"varint,2,opt,name=unsafe,proto3" json:"unsafe,omitempty"`
-	Headers       []string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
-	SuccessRanges []string `protobuf:"bytes,4,rep,name=successRanges,proto3" json:"successRanges,omitempty"`
+	Endpoint         string             `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Unsafe           bool               `protobuf:"varint,2,opt,name=unsafe,proto3" json:"unsafe,omitempty"`
+	Headers          []string           `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	SuccessRanges    []string           `protobuf:"bytes,4,rep,name=successRanges,proto3" json:"successRanges,omitempty"`
+	Method           string             `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Body             string             `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	ResponseMatchers []*ResponseMatcher `protobuf:"bytes,7,rep,name=response_matchers,json=responseMatchers,proto3" json:"response_matchers,omitempty"`
 }
 
 func (x *VerifierConfig) Reset() {
@@ -258,6 +402,98 @@ func (x *VerifierConfig) GetSuccessRanges() []string {
 	return nil
 }
 
+func (x *VerifierConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *VerifierConfig) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *VerifierConfig) GetResponseMatchers() []*ResponseMatcher {
+	if x != nil {
+		return x.ResponseMatchers
+	}
+	return nil
+}
+
+type ResponseMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JsonPath string                 `protobuf:"bytes,1,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`
+	Value    string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Regex    string                 `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	Result   ResponseMatcher_Result `protobuf:"varint,4,opt,name=result,proto3,enum=custom_detectors.ResponseMatcher_Result" json:"result,omitempty"`
+}
+
+func (x *ResponseMatcher) Reset() {
+	*x = ResponseMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_custom_detectors_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseMatcher) ProtoMessage() {}
+
+func (x *ResponseMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_custom_detectors_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseMatcher.ProtoReflect.Descriptor instead.
+func (*ResponseMatcher) Descriptor() ([]byte, []int) {
+	return file_custom_detectors_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResponseMatcher) GetJsonPath() string {
+	if x != nil {
+		return x.JsonPath
+	}
+	return ""
+}
+
+func (x *ResponseMatcher) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ResponseMatcher) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *ResponseMatcher) GetResult() ResponseMatcher_Result {
+	if x != nil {
+		return x.Result
+	}
+	return ResponseMatcher_RESULT_UNSPECIFIED
+}
+
 var File_custom_detectors_proto protoreflect.FileDescriptor
 
 var file_custom_detectors_proto_rawDesc = []byte{
@@ -270,7 +506,7 @@ var file_custom_detectors_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x75, 0x73, 0x74,
 	0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x43, 0x75, 0x73,
 	0x74, 0x6f, 0x6d, 0x52, 0x65, 0x67, 0x65, 0x78, 0x52, 0x09, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x22, 0xec, 0x03, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65,
+	0x6f, 0x72, 0x73, 0x22, 0x9f, 0x06, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x65,
 	0x67, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f,
 	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f,
@@ -297,25 +533,66 @@ var file_custom_detectors_proto_rawDesc = []byte{
 	0x67, 0x65, 0x78, 0x65, 0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72,
 	0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x67, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x65,
-	0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x90, 0x01,
-	0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x6e, 0x73, 0x61, 0x66, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x73,
-	0x61, 0x66, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a,
-	0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x73, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x74, 0x72, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x2f, 0x74, 0x72, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x68, 0x6f, 0x67, 0x2f, 0x76, 0x33, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x67, 0x65, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x75,
+	0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x4e, 0x6f, 0x74,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x53, 0x70, 0x61, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x5f, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x55,
+	0x0a, 0x0f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x52, 0x65, 0x67, 0x65, 0x78, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x38, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x65, 0x78, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x34, 0x0a, 0x0e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45,
+	0x56, 0x45, 0x52, 0x10, 0x02, 0x22, 0x8a, 0x02, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72,
+	0x03, 0x90, 0x01, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x75, 0x6e, 0x73, 0x61, 0x66, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x4e, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x52, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x73, 0x6f, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12,
+	0x40, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x4b, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x4e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x42, 0x44,
+	0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x72, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x74, 0x72, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x68, 0x6f, 0x67, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x62, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -330,22 +607,29 @@ func file_custom_detectors_proto_rawDescGZIP() []byte {
 	return file_custom_detectors_proto_rawDescData
 }
 
-var file_custom_detectors_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_custom_detectors_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_custom_detectors_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_custom_detectors_proto_goTypes = []interface{}{
-	(*CustomDetectors)(nil), // 0: custom_detectors.CustomDetectors
-	(*CustomRegex)(nil),     // 1: custom_detectors.CustomRegex
-	(*VerifierConfig)(nil),  // 2: custom_detectors.VerifierConfig
-	nil,                     // 3: custom_detectors.CustomRegex.RegexEntry
+	(CustomRegex_CleaningPolicy)(0), // 0: custom_detectors.CustomRegex.CleaningPolicy
+	(ResponseMatcher_Result)(0),     // 1: custom_detectors.ResponseMatcher.Result
+	(*CustomDetectors)(nil),         // 2: custom_detectors.CustomDetectors
+	(*CustomRegex)(nil),             // 3: custom_detectors.CustomRegex
+	(*VerifierConfig)(nil),          // 4: custom_detectors.VerifierConfig
+	(*ResponseMatcher)(nil),         // 5: custom_detectors.ResponseMatcher
+	nil,                             // 6: custom_detectors.CustomRegex.RegexEntry
 }
 var file_custom_detectors_proto_depIdxs = []int32{
-	1, // 0: custom_detectors.CustomDetectors.detectors:type_name -> custom_detectors.CustomRegex
-	3, // 1: custom_detectors.CustomRegex.regex:type_name -> custom_detectors.CustomRegex.RegexEntry
-	2, // 2: custom_detectors.CustomRegex.verify:type_name -> custom_detectors.VerifierConfig
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	3, // 0: custom_detectors.CustomDetectors.detectors:type_name -> custom_detectors.CustomRegex
+	6, // 1: custom_detectors.CustomRegex.regex:type_name -> custom_detectors.CustomRegex.RegexEntry
+	4, // 2: custom_detectors.CustomRegex.verify:type_name -> custom_detectors.VerifierConfig
+	0, // 3: custom_detectors.CustomRegex.cleaning_policy:type_name -> custom_detectors.CustomRegex.CleaningPolicy
+	5, // 4: custom_detectors.VerifierConfig.response_matchers:type_name -> custom_detectors.ResponseMatcher
+	1, // 5: custom_detectors.ResponseMatcher.result:type_name -> custom_detectors.ResponseMatcher.Result
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_custom_detectors_proto_init() }
@@ -390,19 +674,32 @@ func file_custom_detectors_proto_init() {
 				return nil
 			}
 		}
+		file_custom_detectors_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_custom_detectors_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_custom_detectors_proto_goTypes,
 		DependencyIndexes: file_custom_detectors_proto_depIdxs,
+		EnumInfos:         file_custom_detectors_proto_enumTypes,
 		MessageInfos:      file_custom_detectors_proto_msgTypes,
 	}.Build()
 	File_custom_detectors_proto = out.File
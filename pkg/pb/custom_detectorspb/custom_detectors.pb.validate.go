@@ -235,6 +235,10 @@ func (m *CustomRegex) validate(all bool) error {
 
 	// no validation rules for PrimaryRegexName
 
+	// no validation rules for MaxCredentialSpan
+
+	// no validation rules for CleaningPolicy
+
 	if len(errors) > 0 {
 		return CustomRegexMultiError(errors)
 	}
@@ -348,6 +352,44 @@ func (m *VerifierConfig) validate(all bool) error {
 
 	// no validation rules for Unsafe
 
+	// no validation rules for Method
+
+	// no validation rules for Body
+
+	for idx, item := range m.GetResponseMatchers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifierConfigValidationError{
+						field:  fmt.Sprintf("ResponseMatchers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifierConfigValidationError{
+						field:  fmt.Sprintf("ResponseMatchers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifierConfigValidationError{
+					field:  fmt.Sprintf("ResponseMatchers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return VerifierConfigMultiError(errors)
 	}
@@ -425,3 +467,111 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = VerifierConfigValidationError{}
+
+// Validate checks the field values on ResponseMatcher with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResponseMatcher) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResponseMatcher with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResponseMatcherMultiError, or nil if none found.
+func (m *ResponseMatcher) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResponseMatcher) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JsonPath
+
+	// no validation rules for Value
+
+	// no validation rules for Regex
+
+	// no validation rules for Result
+
+	if len(errors) > 0 {
+		return ResponseMatcherMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResponseMatcherMultiError is an error wrapping multiple validation errors
+// returned by ResponseMatcher.ValidateAll() if the designated constraints
+// aren't met.
+type ResponseMatcherMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResponseMatcherMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResponseMatcherMultiError) AllErrors() []error { return m }
+
+// ResponseMatcherValidationError is the validation error returned by
+// ResponseMatcher.Validate if the designated constraints aren't met.
+type ResponseMatcherValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResponseMatcherValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResponseMatcherValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResponseMatcherValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResponseMatcherValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResponseMatcherValidationError) ErrorName() string { return "ResponseMatcherValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResponseMatcherValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResponseMatcher.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResponseMatcherValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResponseMatcherValidationError{}
@@ -1070,6 +1070,8 @@ func (m *Git) validate(all bool) error {
 
 	// no validation rules for Line
 
+	// no validation rules for ObjectClass
+
 	if len(errors) > 0 {
 		return GitMultiError(errors)
 	}
@@ -1186,6 +1188,14 @@ func (m *Github) validate(all bool) error {
 
 	// no validation rules for Visibility
 
+	// no validation rules for WorkflowRunId
+
+	// no validation rules for WorkflowJob
+
+	// no validation rules for WorkflowStep
+
+	// no validation rules for ReleaseTag
+
 	if len(errors) > 0 {
 		return GithubMultiError(errors)
 	}
@@ -1304,6 +1314,12 @@ func (m *Gitlab) validate(all bool) error {
 
 	// no validation rules for ProjectOwner
 
+	// no validation rules for PipelineId
+
+	// no validation rules for JobId
+
+	// no validation rules for JobName
+
 	if len(errors) > 0 {
 		return GitlabMultiError(errors)
 	}
@@ -2401,6 +2417,8 @@ func (m *Jenkins) validate(all bool) error {
 
 	// no validation rules for Timestamp
 
+	// no validation rules for File
+
 	if len(errors) > 0 {
 		return JenkinsMultiError(errors)
 	}
@@ -4251,6 +4269,8 @@ func (m *MetaData) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Location
+
 	switch v := m.Data.(type) {
 	case *MetaData_Azure:
 		if v == nil {
@@ -1689,6 +1689,12 @@ func (m *Filesystem) validate(all bool) error {
 
 	// no validation rules for ExcludePathsFile
 
+	// no validation rules for UseIgnoreFiles
+
+	// no validation rules for FollowSymlinks
+
+	// no validation rules for ConfineSymlinks
+
 	if len(errors) > 0 {
 		return FilesystemMultiError(errors)
 	}
@@ -2319,6 +2325,24 @@ func (m *GitLab) validate(all bool) error {
 
 	// no validation rules for RemoveAuthInUrl
 
+	// no validation rules for IncludeIssueComments
+
+	// no validation rules for IncludeMergeRequestComments
+
+	// no validation rules for IncludeSnippets
+
+	// no validation rules for IncludeWikis
+
+	// no validation rules for CommentsTimeframeDays
+
+	// no validation rules for IncludePipelineLogs
+
+	// no validation rules for IncludePipelineArtifacts
+
+	// no validation rules for PipelinesTimeframeDays
+
+	// no validation rules for MaxPipelineDownloadSize
+
 	switch v := m.Credential.(type) {
 	case *GitLab_Token:
 		if v == nil {
@@ -2552,6 +2576,18 @@ func (m *GitHub) validate(all bool) error {
 
 	// no validation rules for RemoveAuthInUrl
 
+	// no validation rules for IncludeActionsLogs
+
+	// no validation rules for IncludeActionsArtifacts
+
+	// no validation rules for ActionsTimeframeDays
+
+	// no validation rules for IncludeReleases
+
+	// no validation rules for MaxReleaseAssetSize
+
+	// no validation rules for MaxActionsDownloadSize
+
 	switch v := m.Credential.(type) {
 	case *GitHub_GithubApp:
 		if v == nil {
@@ -3482,6 +3518,10 @@ func (m *JIRA) validate(all bool) error {
 
 	// no validation rules for InsecureSkipVerifyTls
 
+	// no validation rules for Jql
+
+	// no validation rules for Since
+
 	switch v := m.Credential.(type) {
 	case *JIRA_BasicAuth:
 		if v == nil {
@@ -4299,6 +4339,10 @@ func (m *Slack) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for ExportPath
+
+	// no validation rules for WorkspaceUrl
+
 	switch v := m.Credential.(type) {
 	case *Slack_Token:
 		if v == nil {
@@ -0,0 +1,367 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/giturl"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_BITBUCKET
+
+// tokenUser is the username Bitbucket expects when cloning over HTTPS with an
+// access token instead of a password.
+const tokenUser = "x-token-auth"
+
+type Source struct {
+	name        string
+	sourceID    sources.SourceID
+	jobID       sources.JobID
+	verify      bool
+	concurrency int
+
+	authMethod string
+	user       string
+	token      string
+	cloud      bool
+
+	repos       []string
+	ignoreRepos []glob.Glob
+
+	client      *client
+	git         *git.Git
+	scanOptions *git.ScanOptions
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// WithScanOptions sets the git scan options used for every repository.
+func (s *Source) WithScanOptions(scanOptions *git.ScanOptions) {
+	s.scanOptions = scanOptions
+}
+
+// Init returns an initialized Bitbucket source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.concurrency = concurrency
+	if s.scanOptions == nil {
+		s.scanOptions = git.NewScanOptions()
+	}
+
+	if err := git.CmdCheck(); err != nil {
+		return err
+	}
+
+	var conn sourcespb.Bitbucket
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.repos = conn.GetRepositories()
+	for _, pattern := range conn.GetIgnoreRepos() {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("could not compile ignore repo glob %q: %w", pattern, err)
+		}
+		s.ignoreRepos = append(s.ignoreRepos, g)
+	}
+
+	var authorize func(*http.Request)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Bitbucket_Token:
+		s.authMethod = "TOKEN"
+		s.user = tokenUser
+		s.token = cred.Token
+		authorize = bearerAuth(s.token)
+	case *sourcespb.Bitbucket_Oauth:
+		s.authMethod = "OAUTH"
+		s.user = tokenUser
+		s.token = cred.Oauth.GetAccessToken()
+		authorize = bearerAuth(s.token)
+	case *sourcespb.Bitbucket_BasicAuth:
+		s.authMethod = "BASIC_AUTH"
+		s.user = cred.BasicAuth.GetUsername()
+		s.token = cred.BasicAuth.GetPassword()
+		user, password := s.user, s.token
+		authorize = func(req *http.Request) { req.SetBasicAuth(user, password) }
+	default:
+		return fmt.Errorf("invalid configuration given for source %q (%s)", name, s.Type().String())
+	}
+	if s.token == "" {
+		return fmt.Errorf("no credential provided for source %q (%s)", name, s.Type().String())
+	}
+	log.RedactGlobally(s.token)
+
+	apiURL, cloud, err := resolveEndpoint(conn.GetEndpoint(), conn.GetInstallationType())
+	if err != nil {
+		return err
+	}
+	s.cloud = cloud
+	s.client = &client{
+		httpClient: common.RetryableHTTPClientTimeout(60),
+		apiURL:     apiURL,
+		cloud:      cloud,
+		authorize:  authorize,
+	}
+
+	cfg := &git.Config{
+		SourceName:   s.name,
+		JobID:        s.jobID,
+		SourceID:     s.sourceID,
+		SourceType:   s.Type(),
+		Verify:       s.verify,
+		SkipBinaries: conn.GetSkipBinaries(),
+		SkipArchives: conn.GetSkipArchives(),
+		Concurrency:  concurrency,
+		SourceMetadataFunc: func(file, email, commit, timestamp, repository string, line int64) *source_metadatapb.MetaData {
+			return &source_metadatapb.MetaData{
+				Data: &source_metadatapb.MetaData_Bitbucket{
+					Bitbucket: &source_metadatapb.Bitbucket{
+						Commit:     sanitizer.UTF8(commit),
+						File:       sanitizer.UTF8(file),
+						Email:      sanitizer.UTF8(email),
+						Repository: sanitizer.UTF8(repository),
+						Workspace:  sanitizer.UTF8(workspaceFromURL(repository)),
+						Link:       s.generateLink(repository, commit, file, line),
+						Timestamp:  sanitizer.UTF8(timestamp),
+						Line:       line,
+					},
+				},
+			}
+		},
+	}
+	s.git = git.NewGit(cfg)
+
+	ctx.Logger().V(1).Info("initialized Bitbucket source",
+		"auth_method", s.authMethod,
+		"api_url", apiURL.String(),
+		"cloud", cloud)
+	return nil
+}
+
+// resolveEndpoint returns the REST API root for the configured endpoint and
+// whether it belongs to Bitbucket Cloud. An empty endpoint means bitbucket.org.
+func resolveEndpoint(endpoint string, installation sourcespb.BitbucketInstallationType) (*url.URL, bool, error) {
+	if endpoint == "" {
+		if installation == sourcespb.BitbucketInstallationType_DATA_CENTER {
+			return nil, false, fmt.Errorf("an endpoint is required for Bitbucket Data Center")
+		}
+		u, _ := url.Parse(cloudAPIURL)
+		return u, true, nil
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid Bitbucket endpoint %q: %w", endpoint, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, false, fmt.Errorf("invalid Bitbucket endpoint %q: scheme must be http or https", endpoint)
+	}
+
+	cloud := installation == sourcespb.BitbucketInstallationType_CLOUD
+	if installation == sourcespb.BitbucketInstallationType_AUTODETECT {
+		cloud = u.Hostname() == "bitbucket.org" || u.Hostname() == "api.bitbucket.org"
+	}
+
+	switch {
+	case cloud && u.Hostname() == "bitbucket.org":
+		// Users commonly provide the web URL rather than the API URL.
+		u, _ = url.Parse(cloudAPIURL)
+	case !cloud:
+		u = u.JoinPath(dataCenterAPIPath)
+	}
+	return u, cloud, nil
+}
+
+func bearerAuth(token string) func(*http.Request) {
+	return func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+token) }
+}
+
+// Validate checks that the configured credentials can reach the Bitbucket API
+// and any explicitly configured repositories.
+func (s *Source) Validate(ctx context.Context) []error {
+	if err := s.client.checkAuth(ctx); err != nil {
+		return []error{fmt.Errorf("bitbucket authentication failed using method %v: %w", s.authMethod, err)}
+	}
+
+	var errs []error
+	for _, repo := range s.repos {
+		if err := git.PingRepoUsingToken(ctx, s.token, repo, s.user); err != nil {
+			errs = append(errs, fmt.Errorf("could not reach git repository %q: %w", repo, err))
+		}
+	}
+	if len(s.repos) > 0 && len(s.ignoreRepos) > 0 {
+		errs = append(errs, fmt.Errorf("both repositories and ignore patterns were explicitly configured; ignore patterns will not be used"))
+	}
+	return errs
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var repos []string
+	visitor := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			repos = append(repos, id)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, visitor); err != nil {
+		return err
+	}
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	scanErrs := sources.NewScanErrors()
+	var wg errgroup.Group
+	wg.SetLimit(max(s.concurrency, 1))
+	for i, repoURL := range repos {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(repos), fmt.Sprintf("Repo: %s", repoURL), "")
+		wg.Go(func() error {
+			unit := git.SourceUnit{Kind: git.UnitRepo, ID: repoURL}
+			if err := s.ChunkUnit(ctx, unit, reporter); err != nil {
+				scanErrs.Add(fmt.Errorf("error scanning repo %s: %w", repoURL, err))
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+
+	s.SetProgressComplete(len(repos), len(repos), fmt.Sprintf("Completed scanning source %s", s.name), "")
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	return nil
+}
+
+// Enumerate reports all Bitbucket repositories to be scanned to the reporter.
+// If none are configured, it lists every repository in every workspace (Cloud)
+// or project (Data Center) the credentials can access, skipping ignored ones.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	if len(s.repos) > 0 {
+		for _, repo := range s.repos {
+			if err := reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: repo}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	visit := func(repo repository) error {
+		ctx := context.WithValue(ctx, "repo", repo.FullName)
+		if s.ignoreRepo(repo.FullName) {
+			ctx.Logger().V(3).Info("skipping repository", "reason", "ignored in config")
+			return nil
+		}
+		if repo.CloneURL == "" {
+			return reporter.UnitErr(ctx, fmt.Errorf("repository %q has no HTTP clone URL", repo.FullName))
+		}
+		ctx.Logger().V(3).Info("accepting repository")
+		return reporter.UnitOk(ctx, git.SourceUnit{Kind: git.UnitRepo, ID: repo.CloneURL})
+	}
+	onErr := func(err error) error { return reporter.UnitErr(ctx, err) }
+
+	return s.client.listRepositories(ctx, visit, onErr)
+}
+
+func (s *Source) ignoreRepo(fullName string) bool {
+	for _, g := range s.ignoreRepos {
+		if g.Match(fullName) {
+			return true
+		}
+	}
+	return false
+}
+
+// ChunkUnit clones and reports chunks for the given Bitbucket repository unit.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	repoURL, _ := unit.SourceUnitID()
+
+	path, repo, err := git.CloneRepoUsingToken(ctx, s.token, repoURL, s.user, false)
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+	defer os.RemoveAll(path)
+
+	return s.git.ScanRepo(ctx, repo, path, s.scanOptions, reporter)
+}
+
+// generateLink returns a link to the commit on the Bitbucket web UI.
+func (s *Source) generateLink(repository, commit, file string, line int64) string {
+	if s.cloud {
+		return giturl.GenerateLink(repository, commit, file, line)
+	}
+
+	// Data Center clone URLs look like https://host/scm/PROJECT/repo.git and
+	// commits are browsed at https://host/projects/PROJECT/repos/repo/commits/<sha>.
+	u, err := url.Parse(repository)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 3 || parts[len(parts)-3] != "scm" {
+		return ""
+	}
+	project, slug := parts[len(parts)-2], strings.TrimSuffix(parts[len(parts)-1], ".git")
+	prefix := strings.Join(parts[:len(parts)-3], "/")
+
+	link := url.URL{Scheme: u.Scheme, Host: u.Host}
+	link.Path = "/" + strings.TrimPrefix(strings.Join([]string{prefix, "projects", project, "repos", slug, "commits", commit}, "/"), "/")
+	return link.String()
+}
+
+// workspaceFromURL extracts the workspace (Cloud) or project key (Data Center)
+// from a repository clone URL.
+func workspaceFromURL(repository string) string {
+	u, err := url.Parse(repository)
+	if err != nil {
+		return ""
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
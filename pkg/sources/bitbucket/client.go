@@ -0,0 +1,240 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// cloudAPIURL is the REST API root for bitbucket.org.
+	cloudAPIURL = "https://api.bitbucket.org/2.0"
+	// dataCenterAPIPath is the REST API path of a Bitbucket Data Center instance,
+	// relative to the configured endpoint.
+	dataCenterAPIPath = "rest/api/1.0"
+
+	paginationLimit = 100
+)
+
+// repository is the subset of repository information common to Bitbucket
+// Cloud and Data Center that is needed to scan it.
+type repository struct {
+	// FullName is "workspace/slug" on Cloud and "PROJECT/slug" on Data Center.
+	FullName string
+	CloneURL string
+}
+
+// client is a minimal Bitbucket REST client that supports both Cloud (API 2.0)
+// and Data Center (API 1.0) installations.
+type client struct {
+	httpClient *http.Client
+	apiURL     *url.URL
+	cloud      bool
+	authorize  func(*http.Request)
+}
+
+// listRepositories calls visit for every repository the credentials can see.
+// Errors listing a single workspace or project are passed to onErr and do not
+// stop the enumeration.
+func (c *client) listRepositories(ctx context.Context, visit func(repository) error, onErr func(error) error) error {
+	if c.cloud {
+		return c.listCloudRepositories(ctx, visit, onErr)
+	}
+	return c.listDataCenterRepositories(ctx, visit, onErr)
+}
+
+type cloneLink struct {
+	Name string `json:"name"`
+	Href string `json:"href"`
+}
+
+type cloudWorkspacePage struct {
+	Values []struct {
+		Workspace struct {
+			Slug string `json:"slug"`
+		} `json:"workspace"`
+	} `json:"values"`
+	Next string `json:"next"`
+}
+
+type cloudRepositoryPage struct {
+	Values []struct {
+		FullName string `json:"full_name"`
+		Links    struct {
+			Clone []cloneLink `json:"clone"`
+		} `json:"links"`
+	} `json:"values"`
+	Next string `json:"next"`
+}
+
+func (c *client) listCloudRepositories(ctx context.Context, visit func(repository) error, onErr func(error) error) error {
+	var workspaces []string
+	next := c.endpoint("user/permissions/workspaces", url.Values{"pagelen": {strconv.Itoa(paginationLimit)}})
+	for next != "" {
+		var page cloudWorkspacePage
+		if err := c.get(ctx, next, &page); err != nil {
+			return fmt.Errorf("error listing workspaces: %w", err)
+		}
+		for _, v := range page.Values {
+			workspaces = append(workspaces, v.Workspace.Slug)
+		}
+		next = page.Next
+	}
+	ctx.Logger().V(2).Info("listed workspaces", "count", len(workspaces))
+
+	for _, workspace := range workspaces {
+		next := c.endpoint("repositories/"+url.PathEscape(workspace), url.Values{"pagelen": {strconv.Itoa(paginationLimit)}})
+		for next != "" {
+			var page cloudRepositoryPage
+			if err := c.get(ctx, next, &page); err != nil {
+				if err := onErr(fmt.Errorf("error listing repositories for workspace %q: %w", workspace, err)); err != nil {
+					return err
+				}
+				break
+			}
+			for _, v := range page.Values {
+				repo := repository{FullName: v.FullName, CloneURL: cloneURL(v.Links.Clone, "https")}
+				if err := visit(repo); err != nil {
+					return err
+				}
+			}
+			next = page.Next
+		}
+	}
+	return nil
+}
+
+type dataCenterPage struct {
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+type dataCenterProjectPage struct {
+	dataCenterPage
+	Values []struct {
+		Key string `json:"key"`
+	} `json:"values"`
+}
+
+type dataCenterRepositoryPage struct {
+	dataCenterPage
+	Values []struct {
+		Slug    string `json:"slug"`
+		Project struct {
+			Key string `json:"key"`
+		} `json:"project"`
+		Links struct {
+			Clone []cloneLink `json:"clone"`
+		} `json:"links"`
+	} `json:"values"`
+}
+
+func (c *client) listDataCenterRepositories(ctx context.Context, visit func(repository) error, onErr func(error) error) error {
+	var projects []string
+	for start := 0; ; {
+		var page dataCenterProjectPage
+		if err := c.get(ctx, c.endpoint("projects", pageParams(start)), &page); err != nil {
+			return fmt.Errorf("error listing projects: %w", err)
+		}
+		for _, v := range page.Values {
+			projects = append(projects, v.Key)
+		}
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+		start = page.NextPageStart
+	}
+	ctx.Logger().V(2).Info("listed projects", "count", len(projects))
+
+	for _, project := range projects {
+		for start := 0; ; {
+			var page dataCenterRepositoryPage
+			path := "projects/" + url.PathEscape(project) + "/repos"
+			if err := c.get(ctx, c.endpoint(path, pageParams(start)), &page); err != nil {
+				if err := onErr(fmt.Errorf("error listing repositories for project %q: %w", project, err)); err != nil {
+					return err
+				}
+				break
+			}
+			for _, v := range page.Values {
+				repo := repository{
+					FullName: v.Project.Key + "/" + v.Slug,
+					CloneURL: cloneURL(v.Links.Clone, "http"),
+				}
+				if err := visit(repo); err != nil {
+					return err
+				}
+			}
+			if page.IsLastPage || len(page.Values) == 0 {
+				break
+			}
+			start = page.NextPageStart
+		}
+	}
+	return nil
+}
+
+// checkAuth makes a cheap authenticated request to validate the credentials.
+func (c *client) checkAuth(ctx context.Context) error {
+	var out json.RawMessage
+	if c.cloud {
+		return c.get(ctx, c.endpoint("user", nil), &out)
+	}
+	return c.get(ctx, c.endpoint("projects", url.Values{"limit": {"1"}}), &out)
+}
+
+func (c *client) endpoint(path string, query url.Values) string {
+	u := c.apiURL.JoinPath(path)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (c *client) get(ctx context.Context, reqURL string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create Bitbucket API request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	c.authorize(req)
+
+	ctx.Logger().V(4).Info("executing query", "query_url", reqURL)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request to Bitbucket API: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from Bitbucket API: %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Bitbucket API response: %w", err)
+	}
+	return nil
+}
+
+func pageParams(start int) url.Values {
+	return url.Values{
+		"start": {strconv.Itoa(start)},
+		"limit": {strconv.Itoa(paginationLimit)},
+	}
+}
+
+// cloneURL returns the href of the clone link with the given name, or an empty
+// string if the repository doesn't advertise one.
+func cloneURL(links []cloneLink, name string) string {
+	for _, l := range links {
+		if l.Name == name {
+			return l.Href
+		}
+	}
+	return ""
+}
@@ -0,0 +1,292 @@
+package sources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// DefaultCheckpointInterval is how often a SourceManager configured with a
+// Checkpointer writes the progress of its running jobs to disk.
+const DefaultCheckpointInterval = 30 * time.Second
+
+// checkpointVersion is the format version of the checkpoint file.
+const checkpointVersion = 1
+
+// Checkpointer persists the resume state of running jobs to a file so that a
+// scan interrupted by a crash, OOM or eviction can resume after the process
+// restarts.
+//
+// Each job is identified by its source type and name, which are stable across
+// runs of the same command. Jobs started with EnumerateAndScanWithConfig also
+// record a hash of their source configuration, and a checkpoint saved for a
+// different configuration is rejected rather than resumed. For every job the
+// file records the source's Progress (including EncodedResumeInfo) and, when
+// the source is run with units, the units whose chunks were all scanned, as
+// reported by SourceManager.ChunkScanned. On restart the Progress is handed
+// back to the source before it runs and completed units are skipped. The
+// Progress is saved as the source reports it, so a source that updates its
+// resume info before its chunks are scanned may resume past chunks that were
+// still buffered. Jobs that finish successfully are removed from the file,
+// and the file itself is removed once no unfinished jobs remain.
+type Checkpointer struct {
+	path string
+
+	mu sync.Mutex
+	// saved holds the checkpoints read from disk that have not been claimed
+	// by a job in this process.
+	saved map[string]jobCheckpoint
+	// jobs holds the checkpoints of jobs started by this process.
+	jobs map[string]*checkpointJob
+}
+
+// checkpointFile is the on-disk representation of a Checkpointer.
+type checkpointFile struct {
+	Version int                      `json:"version"`
+	Jobs    map[string]jobCheckpoint `json:"jobs"`
+}
+
+// jobCheckpoint is the persisted resume state of a single job.
+type jobCheckpoint struct {
+	Message           string   `json:"message,omitempty"`
+	EncodedResumeInfo string   `json:"encoded_resume_info,omitempty"`
+	SectionsCompleted int32    `json:"sections_completed,omitempty"`
+	SectionsRemaining int32    `json:"sections_remaining,omitempty"`
+	CompletedUnits    []string `json:"completed_units,omitempty"`
+	ConfigHash        string   `json:"config_hash,omitempty"`
+}
+
+// NewCheckpointer creates a Checkpointer backed by the file at path. If the
+// file exists, the checkpoints it contains are loaded so that matching jobs
+// resume from them.
+func NewCheckpointer(path string) (*Checkpointer, error) {
+	c := &Checkpointer{
+		path:  path,
+		saved: make(map[string]jobCheckpoint),
+		jobs:  make(map[string]*checkpointJob),
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading checkpoint file: %w", err)
+	}
+
+	var file checkpointFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("error parsing checkpoint file %q: %w", path, err)
+	}
+	if file.Version != checkpointVersion {
+		return nil, fmt.Errorf("unsupported checkpoint file version %d", file.Version)
+	}
+	for key, checkpoint := range file.Jobs {
+		c.saved[key] = checkpoint
+	}
+	return c, nil
+}
+
+// Resumable returns the number of jobs loaded from disk that have not yet been
+// resumed.
+func (c *Checkpointer) Resumable() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.saved)
+}
+
+// track registers a job and restores any checkpoint saved for it into the
+// source's Progress. If configHash is set and the saved checkpoint was
+// recorded for a different configuration, an error is returned and the
+// checkpoint is left in place. It is safe to call on a nil Checkpointer, in
+// which case the returned job is nil and all of its methods are no-ops.
+func (c *Checkpointer) track(sourceName string, source Source, configHash string) (*checkpointJob, error) {
+	if c == nil {
+		return nil, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	base := fmt.Sprintf("%s/%s", source.Type(), sourceName)
+	key := base
+	// Running the same source more than once in a process is rare, but
+	// each run needs its own entry.
+	for i := 2; c.jobs[key] != nil; i++ {
+		key = fmt.Sprintf("%s#%d", base, i)
+	}
+
+	saved, ok := c.saved[key]
+	if ok && configHash != "" && saved.ConfigHash != "" && saved.ConfigHash != configHash {
+		return nil, fmt.Errorf("checkpoint for %q in %q was saved for a different source configuration", key, c.path)
+	}
+
+	job := &checkpointJob{
+		progress:       source.GetProgress(),
+		configHash:     configHash,
+		completedUnits: make(map[string]struct{}),
+	}
+	if ok {
+		delete(c.saved, key)
+		job.progress.restore(saved)
+		for _, unit := range saved.CompletedUnits {
+			job.completedUnits[unit] = struct{}{}
+		}
+	}
+	c.jobs[key] = job
+	return job, nil
+}
+
+// hashConnection returns a stable hash of a source connection and, if the
+// source is a ConfigOptioner, its options. It is used to tell whether a
+// checkpoint belongs to the same configuration.
+func hashConnection(conn *anypb.Any, source Source) (string, error) {
+	if conn == nil {
+		return "", nil
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(conn)
+	if err != nil {
+		return "", fmt.Errorf("error hashing source connection: %w", err)
+	}
+	hash := sha256.New()
+	hash.Write(data)
+	if optioner, ok := source.(ConfigOptioner); ok {
+		for _, option := range optioner.ConfigOptions() {
+			// Separate the options so that their boundaries are part of
+			// the hash.
+			hash.Write([]byte{0})
+			hash.Write([]byte(option))
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Save writes the current state of all unfinished jobs to disk. Checkpoints
+// loaded from disk that were not resumed by this process are kept so they are
+// available to a later run. If there is nothing left to resume, the file is
+// removed.
+func (c *Checkpointer) Save() error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	file := checkpointFile{
+		Version: checkpointVersion,
+		Jobs:    make(map[string]jobCheckpoint, len(c.saved)+len(c.jobs)),
+	}
+	for key, checkpoint := range c.saved {
+		file.Jobs[key] = checkpoint
+	}
+	for key, job := range c.jobs {
+		if checkpoint, ok := job.checkpoint(); ok {
+			file.Jobs[key] = checkpoint
+		}
+	}
+	c.mu.Unlock()
+
+	if len(file.Jobs) == 0 {
+		if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error removing checkpoint file: %w", err)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return fmt.Errorf("error encoding checkpoint: %w", err)
+	}
+	// Write to a temporary file and rename it so a crash mid-write never
+	// leaves a truncated checkpoint behind.
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error creating checkpoint file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error writing checkpoint file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing checkpoint file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		return fmt.Errorf("error writing checkpoint file: %w", err)
+	}
+	return nil
+}
+
+// checkpointJob tracks the resume state of a single job started by the
+// SourceManager. A nil *checkpointJob is valid and does nothing.
+type checkpointJob struct {
+	progress   *Progress
+	configHash string
+
+	mu             sync.Mutex
+	completedUnits map[string]struct{}
+	finished       bool
+}
+
+// unitKey returns the identifier a unit is recorded under.
+func unitKey(unit SourceUnit) string {
+	id, kind := unit.SourceUnitID()
+	return fmt.Sprintf("%s:%s", kind, id)
+}
+
+// unitCompleted reports whether the unit was fully scanned by a previous run.
+func (j *checkpointJob) unitCompleted(unit SourceUnit) bool {
+	if j == nil {
+		return false
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	_, ok := j.completedUnits[unitKey(unit)]
+	return ok
+}
+
+// completeUnit records that the unit has been fully scanned.
+func (j *checkpointJob) completeUnit(unit SourceUnit) {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.completedUnits[unitKey(unit)] = struct{}{}
+}
+
+// finish marks the job as successfully completed, so it is dropped from the
+// checkpoint file.
+func (j *checkpointJob) finish() {
+	if j == nil {
+		return
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.finished = true
+}
+
+// checkpoint returns the current resume state of the job. The boolean is
+// false if the job has finished and should not be persisted.
+func (j *checkpointJob) checkpoint() (jobCheckpoint, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.finished {
+		return jobCheckpoint{}, false
+	}
+
+	checkpoint := j.progress.checkpoint()
+	checkpoint.ConfigHash = j.configHash
+	checkpoint.CompletedUnits = make([]string, 0, len(j.completedUnits))
+	for unit := range j.completedUnits {
+		checkpoint.CompletedUnits = append(checkpoint.CompletedUnits, unit)
+	}
+	slices.Sort(checkpoint.CompletedUnits)
+	return checkpoint, true
+}
@@ -0,0 +1,290 @@
+package sources
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+)
+
+// checkpointSource is a DummySource that reports its progress.
+type checkpointSource struct {
+	DummySource
+	progress Progress
+}
+
+func (c *checkpointSource) GetProgress() *Progress { return &c.progress }
+
+// failingUnitChunker fails to chunk the units in fail.
+type failingUnitChunker struct {
+	counterChunker
+	fail map[countChunk]bool
+}
+
+func (c *failingUnitChunker) ChunkUnit(ctx context.Context, unit SourceUnit, reporter ChunkReporter) error {
+	if c.fail[unit.(countChunk)] {
+		return fmt.Errorf("unit %d failed", unit.(countChunk))
+	}
+	return c.counterChunker.ChunkUnit(ctx, unit, reporter)
+}
+
+func TestCheckpointerRestoresProgress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	checkpointer, err := NewCheckpointer(path)
+	require.NoError(t, err)
+	source := &checkpointSource{}
+	_, err = checkpointer.track("s3", source, "")
+	require.NoError(t, err)
+	source.progress.SetProgressComplete(2, 5, "Bucket: b", `{"current_bucket":"b","start_after":"k"}`)
+	require.NoError(t, checkpointer.Save())
+
+	checkpointer, err = NewCheckpointer(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, checkpointer.Resumable())
+
+	// A source with a different name does not pick up the checkpoint.
+	other := &checkpointSource{}
+	_, err = checkpointer.track("gcs", other, "")
+	require.NoError(t, err)
+	assert.Empty(t, other.progress.EncodedResumeInfo)
+
+	resumed := &checkpointSource{}
+	job, err := checkpointer.track("s3", resumed, "")
+	require.NoError(t, err)
+	assert.Equal(t, `{"current_bucket":"b","start_after":"k"}`, resumed.progress.EncodedResumeInfo)
+	assert.Equal(t, int32(2), resumed.progress.SectionsCompleted)
+	assert.Equal(t, int32(5), resumed.progress.SectionsRemaining)
+	assert.Equal(t, 0, checkpointer.Resumable())
+
+	// Finished jobs are dropped, and the file goes with the last of them.
+	job.finish()
+	checkpointer.jobs["1337/gcs"].finish()
+	require.NoError(t, checkpointer.Save())
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestCheckpointerRestoresSubUnitProgress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	checkpointer, err := NewCheckpointer(path)
+	require.NoError(t, err)
+	source := &checkpointSource{}
+	_, err = checkpointer.track("filesystem", source, "")
+	require.NoError(t, err)
+	source.progress.SetEncodedResumeInfoFor("/repo", "/repo/b.txt")
+	require.NoError(t, checkpointer.Save())
+
+	checkpointer, err = NewCheckpointer(path)
+	require.NoError(t, err)
+	resumed := &checkpointSource{}
+	_, err = checkpointer.track("filesystem", resumed, "")
+	require.NoError(t, err)
+	assert.Equal(t, "/repo/b.txt", resumed.progress.GetEncodedResumeInfoFor("/repo"))
+}
+
+func TestCheckpointerRejectsDifferentConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	checkpointer, err := NewCheckpointer(path)
+	require.NoError(t, err)
+	source := &checkpointSource{}
+	_, err = checkpointer.track("s3", source, "abc")
+	require.NoError(t, err)
+	source.progress.SetProgressComplete(2, 5, "Bucket: b", `{"current_bucket":"b"}`)
+	require.NoError(t, checkpointer.Save())
+
+	checkpointer, err = NewCheckpointer(path)
+	require.NoError(t, err)
+	other := &checkpointSource{}
+	_, err = checkpointer.track("s3", other, "def")
+	assert.Error(t, err)
+	assert.Empty(t, other.progress.EncodedResumeInfo)
+	assert.Equal(t, 1, checkpointer.Resumable())
+
+	resumed := &checkpointSource{}
+	_, err = checkpointer.track("s3", resumed, "abc")
+	require.NoError(t, err)
+	assert.Equal(t, `{"current_bucket":"b"}`, resumed.progress.EncodedResumeInfo)
+}
+
+// optionsSource is a checkpointSource with options outside its connection.
+type optionsSource struct {
+	checkpointSource
+	options []string
+}
+
+func (o *optionsSource) ConfigOptions() []string { return o.options }
+
+func TestHashConnectionIncludesConfigOptions(t *testing.T) {
+	conn, err := anypb.New(&sourcespb.Git{Uri: "https://github.com/org/repo.git"})
+	require.NoError(t, err)
+	hash := func(source Source) string {
+		h, err := hashConnection(conn, source)
+		require.NoError(t, err)
+		return h
+	}
+
+	plain := hash(&checkpointSource{})
+	assert.Equal(t, plain, hash(&optionsSource{}))
+	ranges := hash(&optionsSource{options: []string{"range=main..feature"}})
+	assert.NotEqual(t, plain, ranges)
+	assert.Equal(t, ranges, hash(&optionsSource{options: []string{"range=main..feature"}}))
+	assert.NotEqual(t, ranges, hash(&optionsSource{options: []string{"range=main..other"}}))
+	// Option boundaries are part of the hash.
+	assert.NotEqual(t,
+		hash(&optionsSource{options: []string{"object-class=tag", "object-class=stash"}}),
+		hash(&optionsSource{options: []string{"object-class=tagobject-class=stash"}}),
+	)
+}
+
+func TestCheckpointerInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":99}`), 0o600))
+	_, err := NewCheckpointer(path)
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`not json`), 0o600))
+	_, err = NewCheckpointer(path)
+	assert.Error(t, err)
+}
+
+func TestSourceManagerCheckpointSkipsCompletedUnits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	runScan := func(chunker chunker) ([]byte, error) {
+		checkpointer, err := NewCheckpointer(path)
+		require.NoError(t, err)
+		mgr := NewManager(WithBufferedOutput(8), WithSourceUnits(), WithCheckpointer(checkpointer, 0))
+		source := &checkpointSource{DummySource: DummySource{chunker: chunker}}
+		require.NoError(t, source.Init(context.Background(), "dummy", 123, 456, true, nil, 42))
+
+		var data []byte
+		scanned := make(chan struct{})
+		go func() {
+			defer close(scanned)
+			for chunk := range mgr.Chunks() {
+				data = append(data, chunk.Data...)
+				mgr.ChunkScanned(chunk)
+			}
+		}()
+
+		ref, err := mgr.EnumerateAndScan(context.Background(), "dummy", source)
+		require.NoError(t, err)
+		<-ref.Done()
+		waitErr := mgr.Wait()
+		<-scanned
+		return data, waitErr
+	}
+
+	// The first run fails on one unit and leaves a checkpoint behind.
+	data, err := runScan(&failingUnitChunker{
+		counterChunker: counterChunker{count: 5},
+		fail:           map[countChunk]bool{3: true},
+	})
+	assert.Error(t, err)
+	assert.ElementsMatch(t, []byte{0, 1, 2, 4}, data)
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	// The second run only chunks the unit that did not complete.
+	data, err = runScan(&counterChunker{count: 5})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{3}, data)
+	_, err = os.Stat(path)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// blockingChunker chunks unit 1 only once release is closed.
+type blockingChunker struct {
+	counterChunker
+	release chan struct{}
+}
+
+func (c *blockingChunker) ChunkUnit(ctx context.Context, unit SourceUnit, reporter ChunkReporter) error {
+	if unit.(countChunk) == 1 {
+		<-c.release
+	}
+	return c.counterChunker.ChunkUnit(ctx, unit, reporter)
+}
+
+func TestSourceManagerCheckpointKeepsUnscannedUnits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	snapshot := filepath.Join(t.TempDir(), "snapshot.json")
+	readUnits := func() []string {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var file checkpointFile
+		require.NoError(t, json.Unmarshal(data, &file))
+		var units []string
+		for _, job := range file.Jobs {
+			units = append(units, job.CompletedUnits...)
+		}
+		return units
+	}
+
+	checkpointer, err := NewCheckpointer(path)
+	require.NoError(t, err)
+	mgr := NewManager(WithBufferedOutput(8), WithSourceUnits(), WithCheckpointer(checkpointer, time.Hour))
+	chunker := &blockingChunker{counterChunker: counterChunker{count: 2}, release: make(chan struct{})}
+	source := &checkpointSource{DummySource: DummySource{chunker: chunker}}
+	require.NoError(t, source.Init(context.Background(), "dummy", 123, 456, true, nil, 42))
+	_, err = mgr.EnumerateAndScan(context.Background(), "dummy", source)
+	require.NoError(t, err)
+
+	// The chunk of unit 0 was handed off but not scanned yet.
+	chunk := <-mgr.Chunks()
+	assert.Equal(t, []byte{0}, chunk.Data)
+	require.NoError(t, checkpointer.Save())
+	assert.Empty(t, readUnits())
+	// Keep a copy of the checkpoint as it was when the process "crashed".
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(snapshot, data, 0o600))
+
+	mgr.ChunkScanned(chunk)
+	assert.Eventually(t, func() bool {
+		require.NoError(t, checkpointer.Save())
+		return slices.Equal(readUnits(), []string{"test:countChunk(0)"})
+	}, 5*time.Second, 10*time.Millisecond)
+
+	close(chunker.release)
+	go func() {
+		for chunk := range mgr.Chunks() {
+			mgr.ChunkScanned(chunk)
+		}
+	}()
+	require.NoError(t, mgr.Wait())
+
+	// Resuming from the snapshot scans unit 0 again.
+	checkpointer, err = NewCheckpointer(snapshot)
+	require.NoError(t, err)
+	mgr = NewManager(WithBufferedOutput(8), WithSourceUnits(), WithCheckpointer(checkpointer, 0))
+	source = &checkpointSource{DummySource: DummySource{chunker: &counterChunker{count: 2}}}
+	require.NoError(t, source.Init(context.Background(), "dummy", 123, 456, true, nil, 42))
+	var resumed []byte
+	scanned := make(chan struct{})
+	go func() {
+		defer close(scanned)
+		for chunk := range mgr.Chunks() {
+			resumed = append(resumed, chunk.Data...)
+			mgr.ChunkScanned(chunk)
+		}
+	}()
+	ref, err := mgr.EnumerateAndScan(context.Background(), "dummy", source)
+	require.NoError(t, err)
+	<-ref.Done()
+	require.NoError(t, mgr.Wait())
+	<-scanned
+	assert.ElementsMatch(t, []byte{0, 1}, resumed)
+}
@@ -0,0 +1,230 @@
+package confluence
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// apiPath is the path of the Confluence REST API relative to the
+	// configured endpoint. It is the same for Cloud and Data Center.
+	apiPath = "rest/api"
+
+	paginationLimit = 50
+)
+
+type space struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type links struct {
+	WebUI    string `json:"webui"`
+	Download string `json:"download"`
+}
+
+type version struct {
+	Number int    `json:"number"`
+	When   string `json:"when"`
+	By     struct {
+		Email       string `json:"email"`
+		DisplayName string `json:"displayName"`
+	} `json:"by"`
+}
+
+type page struct {
+	ID      string  `json:"id"`
+	Type    string  `json:"type"`
+	Title   string  `json:"title"`
+	Version version `json:"version"`
+	Body    struct {
+		Storage struct {
+			Value string `json:"value"`
+		} `json:"storage"`
+	} `json:"body"`
+	Links links `json:"_links"`
+}
+
+type attachment struct {
+	ID      string  `json:"id"`
+	Title   string  `json:"title"`
+	Version version `json:"version"`
+	Links   links   `json:"_links"`
+}
+
+// pageOf is a single page of results from a Confluence list endpoint.
+type pageOf[T any] struct {
+	Results []T `json:"results"`
+	Links   struct {
+		Next string `json:"next"`
+	} `json:"_links"`
+}
+
+// client is a minimal Confluence REST client.
+type client struct {
+	httpClient *http.Client
+	// baseURL is the configured endpoint, e.g. https://example.atlassian.net/wiki.
+	baseURL   *url.URL
+	authorize func(*http.Request)
+}
+
+// listSpaces calls visit for every space of the given type ("global",
+// "personal" or empty for all) visible to the credentials.
+func (c *client) listSpaces(ctx context.Context, spaceType string, visit func(space) error) error {
+	query := url.Values{"limit": {strconv.Itoa(paginationLimit)}}
+	if spaceType != "" {
+		query.Set("type", spaceType)
+	}
+	return paginate(ctx, c, c.apiEndpoint("space", query), visit)
+}
+
+// getSpace returns the space with the given key.
+func (c *client) getSpace(ctx context.Context, key string) (space, error) {
+	var s space
+	err := c.get(ctx, c.apiEndpoint("space/"+url.PathEscape(key), nil), &s)
+	return s, err
+}
+
+// listPages calls visit for the current version of every page in the space,
+// including its body in storage format.
+func (c *client) listPages(ctx context.Context, spaceKey string, visit func(page) error) error {
+	query := url.Values{
+		"spaceKey": {spaceKey},
+		"type":     {"page"},
+		"expand":   {"body.storage,version"},
+		"limit":    {strconv.Itoa(paginationLimit)},
+	}
+	return paginate(ctx, c, c.apiEndpoint("content", query), visit)
+}
+
+// getPageVersion returns a historic version of a page.
+func (c *client) getPageVersion(ctx context.Context, id string, number int) (page, error) {
+	query := url.Values{
+		"status":  {"historical"},
+		"version": {strconv.Itoa(number)},
+		"expand":  {"body.storage,version"},
+	}
+	var p page
+	err := c.get(ctx, c.apiEndpoint("content/"+url.PathEscape(id), query), &p)
+	return p, err
+}
+
+// listAttachments calls visit for every attachment of a page.
+func (c *client) listAttachments(ctx context.Context, pageID string, visit func(attachment) error) error {
+	query := url.Values{
+		"expand": {"version"},
+		"limit":  {strconv.Itoa(paginationLimit)},
+	}
+	return paginate(ctx, c, c.apiEndpoint("content/"+url.PathEscape(pageID)+"/child/attachment", query), visit)
+}
+
+// download opens the resource at the given path, which is relative to the
+// configured endpoint as returned in _links. The caller must close the body.
+func (c *client) download(ctx context.Context, path string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, c.resolve(path))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code from Confluence: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// checkAuth makes a cheap authenticated request to validate the credentials.
+func (c *client) checkAuth(ctx context.Context) error {
+	var out json.RawMessage
+	return c.get(ctx, c.apiEndpoint("space", url.Values{"limit": {"1"}}), &out)
+}
+
+// webLink returns an absolute link for a path relative to the endpoint.
+func (c *client) webLink(path string) string {
+	if path == "" {
+		return ""
+	}
+	return c.resolve(path)
+}
+
+func (c *client) apiEndpoint(path string, query url.Values) string {
+	u := c.baseURL.JoinPath(apiPath, path)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// resolve turns a path returned by the API into an absolute URL. The API
+// returns paths relative to the endpoint including its context path
+// (e.g. /wiki), so they are joined to the endpoint rather than its host.
+func (c *client) resolve(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	rel, err := url.Parse(path)
+	if err != nil {
+		return c.baseURL.String() + path
+	}
+	u := c.baseURL.JoinPath(rel.Path)
+	u.RawQuery = rel.RawQuery
+	return u.String()
+}
+
+func paginate[T any](ctx context.Context, c *client, next string, visit func(T) error) error {
+	for next != "" {
+		var p pageOf[T]
+		if err := c.get(ctx, next, &p); err != nil {
+			return err
+		}
+		for _, item := range p.Results {
+			if err := visit(item); err != nil {
+				return err
+			}
+		}
+		next = ""
+		if p.Links.Next != "" {
+			next = c.resolve(p.Links.Next)
+		}
+	}
+	return nil
+}
+
+func (c *client) get(ctx context.Context, reqURL string, target any) error {
+	resp, err := c.do(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from Confluence API: %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Confluence API response: %w", err)
+	}
+	return nil
+}
+
+func (c *client) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Confluence request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	c.authorize(req)
+
+	ctx.Logger().V(4).Info("executing query", "query_url", reqURL)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Confluence: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,387 @@
+package confluence
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_CONFLUENCE
+
+// UnitSpace is the SourceUnitKind of a Confluence space.
+const UnitSpace sources.SourceUnitKind = "space"
+
+// Locations reported in the Confluence source metadata.
+const (
+	locationPage       = "page"
+	locationHistory    = "history"
+	locationAttachment = "attachment"
+)
+
+type Source struct {
+	name        string
+	sourceID    sources.SourceID
+	jobID       sources.JobID
+	verify      bool
+	concurrency int
+
+	authMethod         string
+	spaces             []string
+	ignoreSpaces       map[string]struct{}
+	spaceType          string
+	includeAttachments bool
+	skipHistory        bool
+
+	client *client
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.sourceID
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Confluence source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, concurrency int) error {
+	s.name = name
+	s.sourceID = sourceId
+	s.jobID = jobId
+	s.verify = verify
+	s.concurrency = concurrency
+
+	var conn sourcespb.Confluence
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.spaces = conn.GetSpaces()
+	s.ignoreSpaces = make(map[string]struct{}, len(conn.GetIgnoreSpaces()))
+	for _, key := range conn.GetIgnoreSpaces() {
+		s.ignoreSpaces[strings.ToUpper(key)] = struct{}{}
+	}
+	s.includeAttachments = conn.GetIncludeAttachments()
+	s.skipHistory = conn.GetSkipHistory()
+
+	switch conn.GetSpacesScope() {
+	case sourcespb.Confluence_GLOBAL:
+		s.spaceType = "global"
+	case sourcespb.Confluence_PERSONAL:
+		s.spaceType = "personal"
+	default:
+		s.spaceType = ""
+	}
+
+	var authorize func(*http.Request)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Confluence_BasicAuth:
+		s.authMethod = "BASIC_AUTH"
+		user, password := cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword()
+		if password == "" {
+			return fmt.Errorf("Confluence source basic auth credential requires 'password' to be specified")
+		}
+		log.RedactGlobally(password)
+		authorize = func(req *http.Request) { req.SetBasicAuth(user, password) }
+	case *sourcespb.Confluence_Token:
+		s.authMethod = "TOKEN"
+		token := cred.Token
+		if token == "" {
+			return fmt.Errorf("Confluence source token credential requires a token to be specified")
+		}
+		log.RedactGlobally(token)
+		authorize = func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+token) }
+	case *sourcespb.Confluence_Unauthenticated:
+		s.authMethod = "UNAUTHENTICATED"
+		authorize = func(*http.Request) {}
+	default:
+		return fmt.Errorf("unknown or unspecified authentication method provided for Confluence source %q (unauthenticated scans must be explicitly configured)", name)
+	}
+
+	endpoint := strings.TrimSuffix(conn.GetEndpoint(), "/")
+	baseURL, err := url.Parse(endpoint)
+	if err != nil || endpoint == "" {
+		return fmt.Errorf("invalid endpoint URL given for Confluence source: %q", conn.GetEndpoint())
+	}
+	if baseURL.Scheme != "https" && baseURL.Scheme != "http" {
+		return fmt.Errorf("invalid endpoint URL given for Confluence source: %q: scheme must be http or https", conn.GetEndpoint())
+	}
+
+	var opts []func(*roundtripper.RoundTripper)
+	if conn.GetInsecureSkipVerifyTls() {
+		opts = append(opts, roundtripper.WithInsecureTLS())
+	}
+	const retryDelay = time.Second * 30
+	opts = append(opts,
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithLogging(),
+		roundtripper.WithRetryable(
+			roundtripper.WithShouldRetry5XXDuration(retryDelay),
+		),
+	)
+
+	s.client = &client{
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil, opts...)},
+		baseURL:    baseURL,
+		authorize:  authorize,
+	}
+
+	ctx.Logger().V(1).Info("initialized Confluence source",
+		"auth_method", s.authMethod,
+		"endpoint", baseURL.String(),
+		"include_attachments", s.includeAttachments,
+		"skip_history", s.skipHistory)
+	return nil
+}
+
+// Validate checks that the configured credentials can reach the Confluence API
+// and any explicitly configured spaces.
+func (s *Source) Validate(ctx context.Context) []error {
+	if err := s.client.checkAuth(ctx); err != nil {
+		return []error{fmt.Errorf("confluence authentication failed using method %v: %w", s.authMethod, err)}
+	}
+
+	var errs []error
+	for _, key := range s.spaces {
+		if _, err := s.client.getSpace(ctx, key); err != nil {
+			errs = append(errs, fmt.Errorf("could not access space %q: %w", key, err))
+		}
+	}
+	return errs
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var spaces []string
+	visitor := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			spaces = append(spaces, id)
+			return ctx.Err()
+		},
+		VisitErr: func(ctx context.Context, err error) error {
+			ctx.Logger().Error(err, "error enumerating spaces")
+			return nil
+		},
+	}
+	if err := s.Enumerate(ctx, visitor); err != nil {
+		return err
+	}
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	scanErrs := sources.NewScanErrors()
+	var wg errgroup.Group
+	wg.SetLimit(max(s.concurrency, 1))
+	for i, key := range spaces {
+		if common.IsDone(ctx) {
+			break
+		}
+		s.SetProgressComplete(i, len(spaces), fmt.Sprintf("Space: %s", key), "")
+		wg.Go(func() error {
+			unit := sources.CommonSourceUnit{Kind: UnitSpace, ID: key}
+			if err := s.ChunkUnit(ctx, unit, reporter); err != nil {
+				scanErrs.Add(fmt.Errorf("error scanning space %s: %w", key, err))
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+
+	s.SetProgressComplete(len(spaces), len(spaces), fmt.Sprintf("Completed scanning source %s", s.name), "")
+	if scanErrs.Count() > 0 {
+		ctx.Logger().V(2).Info("encountered errors while scanning", "count", scanErrs.Count(), "errors", scanErrs)
+	}
+	return nil
+}
+
+// Enumerate reports the Confluence spaces to be scanned to the reporter.
+// If none are configured, it lists every space in the configured scope,
+// skipping ignored ones.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	report := func(key string) error {
+		if _, ok := s.ignoreSpaces[strings.ToUpper(key)]; ok {
+			ctx.Logger().V(3).Info("skipping space", "space", key, "reason", "ignored in config")
+			return nil
+		}
+		return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitSpace, ID: key})
+	}
+
+	if len(s.spaces) > 0 {
+		for _, key := range s.spaces {
+			if err := report(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := s.client.listSpaces(ctx, s.spaceType, func(sp space) error {
+		return report(sp.Key)
+	})
+	if err != nil {
+		return reporter.UnitErr(ctx, fmt.Errorf("error listing spaces: %w", err))
+	}
+	return nil
+}
+
+// ChunkUnit reports chunks for every page in a space, optionally including
+// each page's historic versions and attachments.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	spaceKey, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "space", spaceKey)
+
+	err := s.client.listPages(ctx, spaceKey, func(p page) error {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		return s.chunkPage(ctx, spaceKey, p, reporter)
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error listing pages in space %q: %w", spaceKey, err))
+	}
+	return nil
+}
+
+// chunkPage reports the current version of a page and, depending on the
+// configuration, its history and attachments. Errors fetching history or
+// attachments are reported to the reporter and do not stop the scan.
+func (s *Source) chunkPage(ctx context.Context, spaceKey string, p page, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "page_id", p.ID)
+	ctx.Logger().V(3).Info("scanning page", "version", p.Version.Number)
+
+	link := s.client.webLink(p.Links.WebUI)
+	if err := s.reportPage(ctx, spaceKey, p, link, locationPage, reporter); err != nil {
+		return err
+	}
+
+	if !s.skipHistory {
+		for number := 1; number < p.Version.Number; number++ {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			old, err := s.client.getPageVersion(ctx, p.ID, number)
+			if err != nil {
+				if err := reporter.ChunkErr(ctx, fmt.Errorf("error getting version %d of page %q: %w", number, p.ID, err)); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := s.reportPage(ctx, spaceKey, old, s.versionLink(p.ID, number), locationHistory, reporter); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !s.includeAttachments {
+		return nil
+	}
+	err := s.client.listAttachments(ctx, p.ID, func(a attachment) error {
+		if err := s.chunkAttachment(ctx, spaceKey, p, a, reporter); err != nil {
+			return reporter.ChunkErr(ctx, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error listing attachments of page %q: %w", p.ID, err))
+	}
+	return nil
+}
+
+func (s *Source) reportPage(ctx context.Context, spaceKey string, p page, link, location string, reporter sources.ChunkReporter) error {
+	text := storageToText(p.Body.Storage.Value)
+	if text == "" {
+		return nil
+	}
+	chunk := sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: s.metadata(spaceKey, p.Title, p.Version, link, location, ""),
+		Data:           []byte(text),
+		Verify:         s.verify,
+	}
+	return reporter.ChunkOk(ctx, chunk)
+}
+
+func (s *Source) chunkAttachment(ctx context.Context, spaceKey string, p page, a attachment, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "attachment", a.Title)
+	if a.Links.Download == "" {
+		return fmt.Errorf("attachment %q of page %q has no download link", a.Title, p.ID)
+	}
+
+	body, err := s.client.download(ctx, a.Links.Download)
+	if err != nil {
+		return fmt.Errorf("error downloading attachment %q of page %q: %w", a.Title, p.ID, err)
+	}
+	defer body.Close()
+
+	chunkSkel := &sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: s.metadata(spaceKey, p.Title, a.Version, s.client.webLink(a.Links.Download), locationAttachment, a.Title),
+		Verify:         s.verify,
+	}
+	ctx.Logger().V(4).Info("scanning attachment")
+	return handlers.HandleFile(ctx, body, chunkSkel, reporter)
+}
+
+func (s *Source) metadata(spaceKey, title string, v version, link, location, file string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Confluence{
+			Confluence: &source_metadatapb.Confluence{
+				Page:      sanitizer.UTF8(title),
+				Space:     sanitizer.UTF8(spaceKey),
+				Version:   strconv.Itoa(v.Number),
+				Link:      link,
+				Email:     sanitizer.UTF8(v.By.Email),
+				Timestamp: v.When,
+				Location:  location,
+				File:      sanitizer.UTF8(file),
+			},
+		},
+	}
+}
+
+// versionLink returns a link to a historic version of a page. This URL form is
+// supported by both Confluence Cloud and Data Center.
+func (s *Source) versionLink(pageID string, number int) string {
+	return s.client.webLink("/pages/viewpage.action?" + url.Values{
+		"pageId":      {pageID},
+		"pageVersion": {strconv.Itoa(number)},
+	}.Encode())
+}
@@ -0,0 +1,62 @@
+package confluence
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// blockElements are storage format elements that start a new line when
+// converted to text, so that values in adjacent paragraphs, table cells or
+// list items don't run together.
+var blockElements = map[string]struct{}{
+	"p": {}, "div": {}, "br": {}, "li": {}, "tr": {}, "td": {}, "th": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {},
+	"pre": {}, "blockquote": {}, "hr": {},
+	"ac:parameter": {}, "ac:plain-text-body": {}, "ac:rich-text-body": {},
+}
+
+// storageToText converts a page body in Confluence storage format (XHTML with
+// ac: and ri: macro elements) to plain text.
+//
+// Besides text nodes it keeps the contents of CDATA sections, which is where
+// code block and no-format macros store their bodies, and link targets, since
+// URLs frequently embed credentials.
+func storageToText(storage string) string {
+	var sb strings.Builder
+	newline := func() {
+		if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "\n") {
+			sb.WriteByte('\n')
+		}
+	}
+
+	z := html.NewTokenizer(strings.NewReader(storage))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return strings.TrimSpace(sb.String())
+		case html.TextToken:
+			sb.Write(z.Text())
+		case html.CommentToken:
+			// The HTML tokenizer reports CDATA sections as comments.
+			data := string(z.Text())
+			if cdata, ok := strings.CutPrefix(data, "[CDATA["); ok {
+				newline()
+				sb.WriteString(strings.TrimSuffix(cdata, "]]"))
+				newline()
+			}
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			tok := z.Token()
+			if _, ok := blockElements[tok.Data]; ok {
+				newline()
+			}
+			for _, attr := range tok.Attr {
+				if attr.Key == "href" || attr.Key == "ri:value" {
+					newline()
+					sb.WriteString(attr.Val)
+					newline()
+				}
+			}
+		}
+	}
+}
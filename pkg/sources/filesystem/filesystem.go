@@ -33,6 +33,10 @@ type Source struct {
 	paths       []string
 	log         logr.Logger
 	filter      *common.Filter
+
+	useIgnoreFiles  bool
+	followSymlinks  bool
+	confineSymlinks bool
 	sources.Progress
 	sources.CommonSourceUnitUnmarshaller
 }
@@ -77,6 +81,9 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 		return fmt.Errorf("unable to create filter: %w", err)
 	}
 	s.filter = filter
+	s.useIgnoreFiles = conn.GetUseIgnoreFiles()
+	s.confineSymlinks = conn.GetConfineSymlinks()
+	s.followSymlinks = conn.GetFollowSymlinks() || s.confineSymlinks
 
 	return nil
 }
@@ -91,7 +98,7 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 		s.SetProgressComplete(i, len(s.paths), fmt.Sprintf("Path: %s", path), "")
 
 		cleanPath := filepath.Clean(path)
-		fileInfo, err := os.Lstat(cleanPath)
+		fileInfo, err := s.stat(cleanPath)
 		if err != nil {
 			logger.Error(err, "unable to get file info")
 			continue
@@ -127,51 +134,114 @@ func (s *Source) scanDir(ctx context.Context, path string, chunksChan chan *sour
 	startState := s.GetEncodedResumeInfoFor(path)
 	resuming := startState != ""
 
-	return fs.WalkDir(os.DirFS(path), ".", func(relativePath string, d fs.DirEntry, err error) error {
-		if err != nil {
-			ctx.Logger().Error(err, "error walking directory")
-			return nil
+	var (
+		ignore ignoreMatcher
+		// visited holds the resolved paths of the directories walked so
+		// far, so that directories reached through symlinks are only walked
+		// once and symlink loops end.
+		visited  = make(map[string]struct{})
+		rootPath string
+	)
+	if s.followSymlinks {
+		var err error
+		if rootPath, err = filepath.EvalSymlinks(path); err != nil {
+			return fmt.Errorf("unable to resolve path: %w", err)
 		}
+	}
 
-		fullPath := filepath.Join(path, relativePath)
+	// walk walks dir, which is path or a symlink to a directory within it.
+	var walk func(dir string) error
+	walk = func(dir string) error {
+		return fs.WalkDir(os.DirFS(dir), ".", func(relativePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				ctx.Logger().Error(err, "error walking directory")
+				return nil
+			}
 
-		// check if the full path is not matching any pattern in include FilterRuleSet and matching any exclude FilterRuleSet.
-		if s.filter != nil && !s.filter.Pass(fullPath) {
-			// skip excluded directories
-			if d.IsDir() && s.filter.ShouldExclude(fullPath) {
-				return fs.SkipDir
+			fullPath := filepath.Join(dir, relativePath)
+			rootRelativePath, err := filepath.Rel(path, fullPath)
+			if err != nil {
+				return err
 			}
+			pathComponents := splitPath(rootRelativePath)
 
-			return nil // skip the file
-		}
+			if s.useIgnoreFiles && len(pathComponents) > 0 && ignore.match(pathComponents, d.IsDir()) {
+				if d.IsDir() {
+					return fs.SkipDir
+				}
+				return nil
+			}
 
-		// Skip over non-regular files. We do this check here to suppress noisy
-		// logs for trying to scan directories and other non-regular files in
-		// our traversal.
-		if !d.Type().IsRegular() {
-			return nil
-		}
+			// check if the full path is not matching any pattern in include FilterRuleSet and matching any exclude FilterRuleSet.
+			if s.filter != nil && !s.filter.Pass(fullPath) {
+				// skip excluded directories
+				if d.IsDir() && s.filter.ShouldExclude(fullPath) {
+					return fs.SkipDir
+				}
 
-		if resuming {
-			// The start state holds the path that last completed
-			// scanning. When we find it, we can start scanning
-			// again on the next one.
-			if fullPath == startState {
-				resuming = false
+				return nil // skip the file
+			}
+
+			if d.IsDir() {
+				if s.followSymlinks {
+					resolved, err := filepath.EvalSymlinks(fullPath)
+					if err != nil {
+						ctx.Logger().Error(err, "unable to resolve directory", "path", fullPath)
+						return fs.SkipDir
+					}
+					if _, ok := visited[resolved]; ok {
+						return fs.SkipDir
+					}
+					visited[resolved] = struct{}{}
+				}
+				if s.useIgnoreFiles {
+					if err := ignore.load(fullPath, pathComponents); err != nil {
+						ctx.Logger().Error(err, "error reading ignore files", "path", fullPath)
+					}
+				}
+				return nil
+			}
+
+			if d.Type()&fs.ModeSymlink != 0 && s.followSymlinks {
+				isDir, ok := s.resolveSymlink(ctx, fullPath, rootPath)
+				if !ok {
+					return nil
+				}
+				if isDir {
+					if err := walk(fullPath); err != nil {
+						ctx.Logger().Error(err, "error walking symlinked directory", "path", fullPath)
+					}
+					return nil
+				}
+			} else if !d.Type().IsRegular() {
+				// Skip over non-regular files. We do this check here to suppress noisy
+				// logs for trying to scan directories and other non-regular files in
+				// our traversal.
+				return nil
 			}
-			return nil
-		}
 
-		workerPool.Go(func() error {
-			if err = s.scanFile(ctx, fullPath, chunksChan); err != nil {
-				ctx.Logger().Error(err, "error scanning file", "path", fullPath, "error", err)
+			if resuming {
+				// The start state holds the path that last completed
+				// scanning. When we find it, we can start scanning
+				// again on the next one.
+				if fullPath == startState {
+					resuming = false
+				}
+				return nil
 			}
-			s.SetEncodedResumeInfoFor(path, fullPath)
+
+			workerPool.Go(func() error {
+				if err = s.scanFile(ctx, fullPath, chunksChan); err != nil {
+					ctx.Logger().Error(err, "error scanning file", "path", fullPath, "error", err)
+				}
+				s.SetEncodedResumeInfoFor(path, fullPath)
+				return nil
+			})
+
 			return nil
 		})
-
-		return nil
-	})
+	}
+	return walk(path)
 }
 
 var skipSymlinkErr = errors.New("skipping symlink")
@@ -182,7 +252,7 @@ func (s *Source) scanFile(ctx context.Context, path string, chunksChan chan *sou
 	if err != nil {
 		return fmt.Errorf("unable to stat file: %w", err)
 	}
-	if fileStat.Mode()&os.ModeSymlink != 0 {
+	if fileStat.Mode()&os.ModeSymlink != 0 && !s.followSymlinks {
 		return skipSymlinkErr
 	}
 
@@ -238,7 +308,7 @@ func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporte
 	logger := ctx.Logger().WithValues("path", path)
 
 	cleanPath := filepath.Clean(path)
-	fileInfo, err := os.Lstat(cleanPath)
+	fileInfo, err := s.stat(cleanPath)
 	if err != nil {
 		return reporter.ChunkErr(ctx, fmt.Errorf("unable to get file info: %w", err))
 	}
@@ -3,6 +3,7 @@ package filesystem
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -378,6 +379,92 @@ func TestScanSubDirFile(t *testing.T) {
 	require.Equal(t, 0, len(reporter.ChunkErrs), "Expected no errors")
 }
 
+func TestUseIgnoreFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		".gitignore":                  "node_modules/\n*.log\n",
+		".trufflehogignore":           "# keep the debug log\n!debug.log\nfixtures/\n",
+		"main.go":                     "main",
+		"app.log":                     "log",
+		"debug.log":                   "debug",
+		"node_modules/pkg/index.js":   "index",
+		"fixtures/key.pem":            "key",
+		"service/.gitignore":          "/build\n",
+		"service/build/out.js":        "out",
+		"service/src/build/config.js": "config",
+	})
+
+	assert.Equal(t, []string{
+		".gitignore", ".trufflehogignore", "app.log", "debug.log", "fixtures/key.pem", "main.go",
+		"node_modules/pkg/index.js", "service/.gitignore", "service/build/out.js", "service/src/build/config.js",
+	}, scanFiles(t, dir, &sourcespb.Filesystem{}))
+	assert.Equal(t, []string{
+		".gitignore", ".trufflehogignore", "debug.log", "main.go", "service/.gitignore", "service/src/build/config.js",
+	}, scanFiles(t, dir, &sourcespb.Filesystem{UseIgnoreFiles: true}))
+}
+
+func TestFollowSymlinks(t *testing.T) {
+	t.Parallel()
+
+	outside := t.TempDir()
+	writeFiles(t, outside, map[string]string{"secrets.env": "outside"})
+
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"config/app.env": "inside",
+		"main.go":        "main",
+	})
+	require.NoError(t, os.Symlink(filepath.Join(dir, "config"), filepath.Join(dir, "linked-config")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "main.go"), filepath.Join(dir, "linked-main.go")))
+	require.NoError(t, os.Symlink(dir, filepath.Join(dir, "config", "loop")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(dir, "outside")))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, "broken")))
+
+	assert.Equal(t, []string{"config/app.env", "main.go"}, scanFiles(t, dir, &sourcespb.Filesystem{}))
+	// Directories are scanned once, whichever path reaches them first.
+	assert.Equal(t, []string{"config/app.env", "linked-main.go", "main.go", "outside/secrets.env"},
+		scanFiles(t, dir, &sourcespb.Filesystem{FollowSymlinks: true}))
+	assert.Equal(t, []string{"config/app.env", "linked-main.go", "main.go"},
+		scanFiles(t, dir, &sourcespb.Filesystem{ConfineSymlinks: true}))
+}
+
+// writeFiles creates the files in dir, along with their parent directories.
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+}
+
+// scanFiles scans dir with the given connection and returns the sorted paths
+// of the scanned files, relative to dir.
+func scanFiles(t *testing.T, dir string, connection *sourcespb.Filesystem) []string {
+	t.Helper()
+	ctx := context.Background()
+
+	conn, err := anypb.New(connection)
+	require.NoError(t, err)
+	s := Source{}
+	require.NoError(t, s.Init(ctx, "test", 0, 0, true, conn, 1))
+
+	reporter := sourcestest.TestReporter{}
+	require.NoError(t, s.ChunkUnit(ctx, sources.CommonSourceUnit{ID: dir}, &reporter))
+	require.Empty(t, reporter.ChunkErrs)
+
+	var files []string
+	for _, chunk := range reporter.Chunks {
+		rel, err := filepath.Rel(dir, chunk.SourceMetadata.GetFilesystem().GetFile())
+		require.NoError(t, err)
+		files = append(files, filepath.ToSlash(rel))
+	}
+	sort.Strings(files)
+	return files
+}
+
 // createTempFile is a helper function to create a temporary file in the given
 // directory with the provided contents. If dir is "", the operating system's
 // temp directory is used.
@@ -0,0 +1,65 @@
+package filesystem
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/format/gitignore"
+)
+
+// ignoreFileNames are the gitignore-style files whose patterns apply to the
+// directory they are found in and its subdirectories. Patterns of later files
+// take precedence, so .trufflehogignore can re-include paths ignored by git.
+var ignoreFileNames = []string{".gitignore", ".trufflehogignore"}
+
+// ignoreMatcher matches paths against the patterns of the ignore files found
+// while walking a directory tree.
+type ignoreMatcher struct {
+	patterns []gitignore.Pattern
+}
+
+// load reads the ignore files of dir, whose path components relative to the
+// walked root are domain.
+func (m *ignoreMatcher) load(dir string, domain []string) error {
+	for _, name := range ignoreFileNames {
+		f, err := os.Open(filepath.Join(dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+				continue
+			}
+			m.patterns = append(m.patterns, gitignore.ParsePattern(line, domain))
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// match reports whether the path, split into its components relative to the
+// walked root, is ignored.
+func (m *ignoreMatcher) match(path []string, isDir bool) bool {
+	return gitignore.NewMatcher(m.patterns).Match(path, isDir)
+}
+
+// splitPath splits a relative path into its components.
+func splitPath(relativePath string) []string {
+	if relativePath == "." {
+		return nil
+	}
+	return strings.Split(filepath.ToSlash(relativePath), "/")
+}
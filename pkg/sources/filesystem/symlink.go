@@ -0,0 +1,47 @@
+package filesystem
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+// stat returns the file info of path, following it if it is a symlink and
+// symlinks are followed.
+func (s *Source) stat(path string) (fs.FileInfo, error) {
+	fileInfo, err := os.Lstat(path)
+	if err != nil || fileInfo.Mode()&os.ModeSymlink == 0 || !s.followSymlinks {
+		return fileInfo, err
+	}
+	return os.Stat(path)
+}
+
+// resolveSymlink resolves the symlink at path and reports whether its target
+// is a directory, and whether the target should be scanned: it must be a
+// directory or regular file and, if symlinks are confined, be inside root.
+func (s *Source) resolveSymlink(ctx context.Context, path, root string) (isDir, ok bool) {
+	logger := ctx.Logger().WithValues("path", path)
+	target, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		logger.V(2).Info("skipping unresolvable symlink", "error", err)
+		return false, false
+	}
+	if s.confineSymlinks {
+		if rel, err := filepath.Rel(root, target); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			logger.V(2).Info("skipping symlink to outside of the scanned path", "target", target)
+			return false, false
+		}
+	}
+	fileInfo, err := os.Stat(target)
+	if err != nil {
+		logger.V(2).Info("skipping unresolvable symlink", "error", err)
+		return false, false
+	}
+	if !fileInfo.IsDir() && !fileInfo.Mode().IsRegular() {
+		return false, false
+	}
+	return fileInfo.IsDir(), true
+}
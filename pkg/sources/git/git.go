@@ -49,6 +49,21 @@ type Source struct {
 	useCustomContentWriter bool
 	git                    *Git
 	scanOptions            *ScanOptions
+	revisions              []string
+	ranges                 []string
+	stagedOnly             bool
+	objectClasses          []ObjectClass
+
+	// scanState, if set, makes the scan of the repository at conn.Uri
+	// incremental. The repository is cloned to stateDir and recorded in the
+	// state under stateKey and stateRef.
+	scanState *ScanState
+	stateKey  string
+	stateRef  string
+	stateDir  string
+	// stateTip is the tip of the scanned ref, set once the incremental scan
+	// of the repository has produced all of its chunks.
+	stateTip string
 
 	sources.Progress
 	conn *sourcespb.Git
@@ -57,6 +72,63 @@ type Source struct {
 // WithCustomContentWriter sets the useCustomContentWriter flag on the source.
 func (s *Source) WithCustomContentWriter() { s.useCustomContentWriter = true }
 
+// WithRevisions limits the scan to the commits selected by the given `git log`
+// revision arguments. It must be called before Init.
+func (s *Source) WithRevisions(revisions []string) { s.revisions = revisions }
+
+// WithRanges limits the scan to the commits in the given `git rev-list`
+// ranges, e.g. "main..feature". It must be called before Init.
+func (s *Source) WithRanges(ranges []string) { s.ranges = ranges }
+
+// WithStagedOnly limits the scan to staged changes. It must be called before Init.
+func (s *Source) WithStagedOnly() { s.stagedOnly = true }
+
+// WithObjectClasses additionally scans the objects of the given classes, which
+// are not part of the history of the repository's refs. It must be called
+// before Init.
+func (s *Source) WithObjectClasses(classes []ObjectClass) { s.objectClasses = classes }
+
+// WithScanState makes the scan incremental: only the commits added to the
+// scanned ref since the commit recorded in state are scanned. The state is
+// only updated by SaveScanState. It must be called before Init.
+func (s *Source) WithScanState(state *ScanState) { s.scanState = state }
+
+// SaveScanState records the tip of the scanned ref in the scan state and
+// writes it to disk. It must only be called once the chunks of the scan have
+// been fully scanned, so that the commits of an interrupted scan are scanned
+// again by the next run. It does nothing if the scan was not incremental or
+// did not complete.
+func (s *Source) SaveScanState() error {
+	if s.scanState == nil || s.stateTip == "" {
+		return nil
+	}
+	s.scanState.SetLastScanned(s.stateKey, s.stateRef, s.stateTip)
+	return s.scanState.Save()
+}
+
+// ConfigOptions implements sources.ConfigOptioner. It reports the options set
+// through the With* methods and, for an incremental scan, the commit the scan
+// state records for the scanned ref.
+func (s *Source) ConfigOptions() []string {
+	var options []string
+	for _, revision := range s.revisions {
+		options = append(options, "revision="+revision)
+	}
+	for _, commitRange := range s.ranges {
+		options = append(options, "range="+commitRange)
+	}
+	if s.stagedOnly {
+		options = append(options, "staged-only")
+	}
+	for _, class := range s.objectClasses {
+		options = append(options, "object-class="+string(class))
+	}
+	if s.scanState != nil {
+		options = append(options, "last-scanned="+s.scanState.LastScanned(s.stateKey, s.stateRef))
+	}
+	return options
+}
+
 type Git struct {
 	sourceType         sourcespb.SourceType
 	sourceName         string
@@ -166,6 +238,19 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 			return fmt.Errorf("error preparing repo: %w", err)
 		}
 		conn.Directories = append(conn.Directories, repoPath)
+
+		if s.scanState != nil {
+			// Without a branch the whole history of every ref is scanned,
+			// which a single last scanned commit can't describe.
+			if conn.GetHead() == "" {
+				return fmt.Errorf("incremental scans require a branch")
+			}
+			key, err := scanStateKey(uri)
+			if err != nil {
+				return fmt.Errorf("error normalizing repo URI: %w", err)
+			}
+			s.stateKey, s.stateDir, s.stateRef = key, repoPath, conn.GetHead()
+		}
 	}
 
 	filter, err := common.FilterFromFiles(conn.IncludePathsFile, conn.ExcludePathsFile)
@@ -190,6 +275,18 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 	if isBare := conn.GetBare(); isBare {
 		opts = append(opts, ScanOptionBare(isBare))
 	}
+	if len(s.revisions) > 0 {
+		opts = append(opts, ScanOptionRevisions(s.revisions))
+	}
+	if len(s.ranges) > 0 {
+		opts = append(opts, ScanOptionRanges(s.ranges))
+	}
+	if s.stagedOnly {
+		opts = append(opts, ScanOptionStagedOnly(true))
+	}
+	if len(s.objectClasses) > 0 {
+		opts = append(opts, ScanOptionObjectClasses(s.objectClasses))
+	}
 	s.withScanOptions(NewScanOptions(opts...))
 
 	s.conn = &conn
@@ -345,7 +442,20 @@ func (s *Source) scanDir(ctx context.Context, gitDir string, reporter sources.Ch
 			defer os.RemoveAll(gitDir)
 		}
 
-		return s.git.ScanRepo(ctx, repo, gitDir, s.scanOptions, reporter)
+		if s.scanState == nil || gitDir != s.stateDir {
+			return s.git.ScanRepo(ctx, repo, gitDir, s.scanOptions, reporter)
+		}
+		scanOptions, tip, err := s.incrementalScanOptions(ctx, repo)
+		if err != nil {
+			return err
+		}
+		if err := s.git.ScanRepo(ctx, repo, gitDir, scanOptions, reporter); err != nil {
+			return err
+		}
+		if ctx.Err() == nil {
+			s.stateTip = tip
+		}
+		return nil
 	}()
 	if err != nil {
 		return reporter.ChunkErr(ctx, err)
@@ -353,6 +463,52 @@ func (s *Source) scanDir(ctx context.Context, gitDir string, reporter sources.Ch
 	return nil
 }
 
+// incrementalScanOptions returns the options to scan repo from the commit
+// recorded in the scan state, along with the tip commit of the scanned ref to
+// record once the scan has been fully processed. The commits reachable from the tip but not
+// from the recorded commit are scanned, which includes older commits merged
+// since. If the recorded commit is no longer an ancestor of the tip, e.g.
+// because the ref was force-pushed, the scan falls back to the full history.
+func (s *Source) incrementalScanOptions(ctx context.Context, repo *git.Repository) (*ScanOptions, string, error) {
+	tip, err := resolveHash(repo, s.scanOptions.HeadHash)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to resolve head: %w", err)
+	}
+
+	scanOptions := *s.scanOptions
+	last := s.scanState.LastScanned(s.stateKey, s.stateRef)
+	if last == "" {
+		return &scanOptions, tip, nil
+	}
+
+	logger := ctx.Logger().WithValues("ref", s.stateRef, "last_scanned", last)
+	if !isAncestor(repo, last, tip) {
+		logger.Info("last scanned commit is no longer in the history of the ref, possibly due to a force-push; scanning the full history")
+		return &scanOptions, tip, nil
+	}
+	logger.V(1).Info("scanning commits since the last scan", "tip", tip)
+	scanOptions.Revisions = []string{tip, "^" + last}
+	return &scanOptions, tip, nil
+}
+
+// isAncestor reports whether the commit ancestor exists in repo and is an
+// ancestor of, or the same as, the commit descendant.
+func isAncestor(repo *git.Repository, ancestor, descendant string) bool {
+	if !plumbing.IsHash(ancestor) {
+		return false
+	}
+	ancestorCommit, err := repo.CommitObject(plumbing.NewHash(ancestor))
+	if err != nil {
+		return false
+	}
+	descendantCommit, err := repo.CommitObject(plumbing.NewHash(descendant))
+	if err != nil {
+		return false
+	}
+	ok, err := ancestorCommit.IsAncestor(descendantCommit)
+	return err == nil && ok
+}
+
 func RepoFromPath(path string, isBare bool) (*git.Repository, error) {
 	options := &git.PlainOpenOptions{}
 	if !isBare {
@@ -628,7 +784,26 @@ func (s *Git) ScanCommits(ctx context.Context, repo *git.Repository, path string
 		logValues = append(logValues, "max_depth", scanOptions.MaxDepth)
 	}
 
-	diffChan, err := s.parser.RepoPath(repoCtx, path, scanOptions.HeadHash, scanOptions.BaseHash == "", scanOptions.ExcludeGlobs, scanOptions.Bare)
+	head, revisions := scanOptions.HeadHash, []string(nil)
+	if len(scanOptions.Revisions) > 0 {
+		head, revisions = scanOptions.Revisions[0], scanOptions.Revisions[1:]
+		logValues = append(logValues, "revisions", scanOptions.Revisions)
+	}
+
+	var (
+		diffChan chan *gitparse.Diff
+		err      error
+	)
+	switch {
+	case len(scanOptions.commits) > 0:
+		logValues = append(logValues, "commits", len(scanOptions.commits))
+		diffChan, err = s.parser.CommitsPath(repoCtx, path, scanOptions.commits, scanOptions.ExcludeGlobs, scanOptions.Bare)
+	case len(scanOptions.Ranges) > 0:
+		logValues = append(logValues, "ranges", len(scanOptions.Ranges))
+		diffChan, err = s.parser.RangesPath(repoCtx, path, scanOptions.Ranges, scanOptions.BaseHash == "", scanOptions.ExcludeGlobs, scanOptions.Bare)
+	default:
+		diffChan, err = s.parser.RepoPath(repoCtx, path, head, scanOptions.BaseHash == "", scanOptions.ExcludeGlobs, scanOptions.Bare, revisions...)
+	}
 	if err != nil {
 		return err
 	}
@@ -1004,12 +1179,29 @@ func (s *Git) ScanRepo(ctx context.Context, repo *git.Repository, repoPath strin
 	// Reset the repo-specific commit counter
 	atomic.StoreUint64(&s.repoCommitsScanned, 0)
 
+	if scanOptions.StagedOnly {
+		if err := s.ScanStaged(ctx, repo, repoPath, scanOptions, reporter); err != nil {
+			s.metrics.RecordRepoScanned(statusFailure)
+			return err
+		}
+		s.metrics.RecordRepoScanned(statusSuccess)
+		return nil
+	}
+
 	if err := s.ScanCommits(ctx, repo, repoPath, scanOptions, reporter); err != nil {
 		// Record that we've failed to scan this repo
 		s.metrics.RecordRepoScanned(statusFailure)
 		return err
 	}
-	if !scanOptions.Bare {
+	if len(scanOptions.ObjectClasses) > 0 {
+		if err := s.ScanObjects(ctx, repo, repoPath, scanOptions, reporter); err != nil {
+			s.metrics.RecordRepoScanned(statusFailure)
+			return err
+		}
+	}
+	// Explicit revisions and ranges select exactly the commits to scan, so
+	// the working tree is not part of the scan.
+	if !scanOptions.Bare && len(scanOptions.Revisions) == 0 && len(scanOptions.Ranges) == 0 {
 		if err := s.ScanStaged(ctx, repo, repoPath, scanOptions, reporter); err != nil {
 			ctx.Logger().V(1).Info("error scanning unstaged changes", "error", err)
 		}
@@ -1321,6 +1513,19 @@ func HandleBinary(
 		return nil
 	}
 
+	return handleBlob(fileCtx, gitDir, reporter, chunkSkel, commitHash.String()+":"+path, skipArchives)
+}
+
+// handleBlob chunks the content of the blob named by object, e.g.
+// "<commit>:<path>" or a blob hash.
+func handleBlob(
+	ctx context.Context,
+	gitDir string,
+	reporter sources.ChunkReporter,
+	chunkSkel *sources.Chunk,
+	object string,
+	skipArchives bool,
+) (err error) {
 	const (
 		cmdTimeout = 60 * time.Second
 		waitDelay  = 5 * time.Second
@@ -1340,10 +1545,10 @@ func HandleBinary(
 
 	// Create a timeout context for the 'git cat-file' command to ensure it does not run indefinitely.
 	// This prevents potential resource exhaustion by terminating the command if it exceeds the specified duration.
-	catFileCtx, cancel := context.WithTimeoutCause(fileCtx, cmdTimeout, errors.New("git cat-file timeout"))
+	catFileCtx, cancel := context.WithTimeoutCause(ctx, cmdTimeout, errors.New("git cat-file timeout"))
 	defer cancel()
 
-	cmd := exec.CommandContext(catFileCtx, "git", "-C", gitDir, "cat-file", "blob", commitHash.String()+":"+path)
+	cmd := exec.CommandContext(catFileCtx, "git", "-C", gitDir, "cat-file", "blob", object)
 	var stderr bytes.Buffer
 	cmd.Stderr = &stderr
 	cmd.WaitDelay = waitDelay // give the command a chance to finish before the timeout :)
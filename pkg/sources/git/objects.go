@@ -0,0 +1,298 @@
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// objectClasses lists the object classes in the order they are scanned. A
+// commit can belong to several classes, e.g. an older stash entry is only
+// reachable from the reflog of refs/stash, so it is scanned for the first one.
+var objectClasses = []ObjectClass{ObjectClassTag, ObjectClassStash, ObjectClassReflog, ObjectClassDangling}
+
+// ScanObjects scans the objects of the classes in scanOptions.ObjectClasses,
+// which are not part of the history of the repository's refs. The chunks of
+// each object carry its class in their metadata.
+func (s *Git) ScanObjects(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, reporter sources.ChunkReporter) error {
+	remoteURL := GetSafeRemoteURL(repo, "origin")
+	seen := make(map[string]struct{})
+	unseen := func(commits []string) []string {
+		var filtered []string
+		for _, commit := range commits {
+			if _, ok := seen[commit]; ok {
+				continue
+			}
+			seen[commit] = struct{}{}
+			filtered = append(filtered, commit)
+		}
+		return filtered
+	}
+
+	for _, class := range objectClasses {
+		if !slices.Contains(scanOptions.ObjectClasses, class) {
+			continue
+		}
+		classCtx := context.WithValue(ctx, "object_class", string(class))
+		classReporter := objectClassReporter{ChunkReporter: reporter, class: class}
+
+		var err error
+		switch class {
+		case ObjectClassTag:
+			err = s.scanTags(classCtx, repo, remoteURL, classReporter)
+		case ObjectClassStash:
+			var commits []string
+			if commits, err = stashCommits(path); err == nil {
+				err = s.scanObjectCommits(classCtx, repo, path, scanOptions, unseen(commits), classReporter)
+			}
+		case ObjectClassReflog:
+			var commits []string
+			if commits, err = gitFields(path, nil, "rev-list", "--reflog", "--not", "--all"); err == nil {
+				err = s.scanObjectCommits(classCtx, repo, path, scanOptions, unseen(commits), classReporter)
+			}
+		case ObjectClassDangling:
+			err = s.scanDangling(classCtx, repo, path, scanOptions, remoteURL, unseen, classReporter)
+		}
+		if err != nil {
+			return fmt.Errorf("error scanning %s objects: %w", class, err)
+		}
+	}
+	return nil
+}
+
+// scanObjectCommits scans exactly the given commits, without their history.
+func (s *Git) scanObjectCommits(ctx context.Context, repo *git.Repository, path string, scanOptions *ScanOptions, commits []string, reporter sources.ChunkReporter) error {
+	if len(commits) == 0 {
+		return nil
+	}
+	return s.ScanCommits(ctx, repo, path, &ScanOptions{
+		Filter:       scanOptions.Filter,
+		ExcludeGlobs: scanOptions.ExcludeGlobs,
+		Bare:         scanOptions.Bare,
+		commits:      commits,
+	}, reporter)
+}
+
+// scanTags scans the messages of the annotated tags of the repository.
+func (s *Git) scanTags(ctx context.Context, repo *git.Repository, remoteURL string, reporter sources.ChunkReporter) error {
+	refs, err := repo.Tags()
+	if err != nil {
+		return err
+	}
+	defer refs.Close()
+
+	return refs.ForEach(func(ref *plumbing.Reference) error {
+		tag, err := repo.TagObject(ref.Hash())
+		if errors.Is(err, plumbing.ErrObjectNotFound) {
+			// Lightweight tags have no message.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return s.scanTag(ctx, tag, remoteURL, reporter)
+	})
+}
+
+// scanTag scans the name, tagger and message of an annotated tag. The tag
+// object's hash is reported as the commit.
+func (s *Git) scanTag(ctx context.Context, tag *object.Tag, remoteURL string, reporter sources.ChunkReporter) error {
+	var (
+		tagger   = tag.Tagger.String()
+		when     = tag.Tagger.When.UTC().Format("2006-01-02 15:04:05 -0700")
+		metadata = s.sourceMetadataFunc("", tagger, tag.Hash.String(), when, remoteURL, 0)
+		sb       strings.Builder
+	)
+	sb.WriteString(tag.Name)
+	sb.WriteString("\n")
+	sb.WriteString(tagger)
+	sb.WriteString("\n")
+	sb.WriteString(tag.Message)
+	chunk := sources.Chunk{
+		SourceName:     s.sourceName,
+		SourceID:       s.sourceID,
+		JobID:          s.jobID,
+		SourceType:     s.sourceType,
+		SourceMetadata: metadata,
+		Data:           []byte(sb.String()),
+		Verify:         s.verify,
+	}
+	return reporter.ChunkOk(ctx, chunk)
+}
+
+// scanDangling scans the unreachable commits, blobs and tags that `git fsck
+// --lost-found` would recover. Unlike it, nothing is written to the
+// repository.
+func (s *Git) scanDangling(
+	ctx context.Context,
+	repo *git.Repository,
+	path string,
+	scanOptions *ScanOptions,
+	remoteURL string,
+	unseen func([]string) []string,
+	reporter sources.ChunkReporter,
+) error {
+	out, err := gitOutput(path, nil, "fsck", "--dangling", "--no-progress")
+	if err != nil {
+		// fsck exits with an error on damaged repositories, which are the
+		// ones most likely to have dangling objects, so scan whatever it
+		// reported.
+		if out == "" {
+			return err
+		}
+		ctx.Logger().Info("git fsck reported errors, scanning the dangling objects it found", "error", err)
+	}
+
+	// Besides "dangling <type> <hash>" lines, fsck reports missing objects
+	// and broken links, which are skipped.
+	var tips, blobs, tags []string
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != "dangling" {
+			continue
+		}
+		switch hash := fields[2]; fields[1] {
+		case "commit":
+			tips = append(tips, hash)
+		case "blob":
+			blobs = append(blobs, hash)
+		case "tag":
+			tags = append(tags, hash)
+		}
+	}
+
+	// Only the tips of dangling history are reported, so walk it down to the
+	// reachable commits.
+	if len(tips) > 0 {
+		stdin := strings.NewReader(strings.Join(tips, "\n") + "\n")
+		commits, err := gitFields(path, stdin, "rev-list", "--stdin", "--not", "--all", "--reflog")
+		if err != nil {
+			return err
+		}
+		if err := s.scanObjectCommits(ctx, repo, path, scanOptions, unseen(commits), reporter); err != nil {
+			return err
+		}
+	}
+
+	for _, hash := range tags {
+		tag, err := repo.TagObject(plumbing.NewHash(hash))
+		if err != nil {
+			ctx.Logger().Error(err, "error reading dangling tag", "tag", hash)
+			continue
+		}
+		if err := s.scanTag(ctx, tag, remoteURL, reporter); err != nil {
+			return err
+		}
+	}
+
+	gitDir := getGitDir(path, scanOptions)
+	for _, hash := range blobs {
+		chunkSkel := &sources.Chunk{
+			SourceName:     s.sourceName,
+			SourceID:       s.sourceID,
+			JobID:          s.jobID,
+			SourceType:     s.sourceType,
+			SourceMetadata: s.sourceMetadataFunc("", "", hash, "", remoteURL, 0),
+			Verify:         s.verify,
+		}
+		blobCtx := context.WithValue(ctx, "blob", hash)
+		if err := handleBlob(blobCtx, gitDir, reporter, chunkSkel, hash, s.skipArchives); err != nil {
+			ctx.Logger().Error(err, "error handling dangling blob", "blob", hash)
+		}
+	}
+	return nil
+}
+
+// stashCommits returns the commits of the stash entries of the repository at
+// path: the working tree commit of each entry, along with its index commit
+// and, if any, its untracked files commit.
+func stashCommits(path string) ([]string, error) {
+	if err := exec.Command("git", "-C", path, "rev-parse", "--verify", "--quiet", "refs/stash").Run(); err != nil {
+		// There are no stash entries.
+		return nil, nil
+	}
+
+	lines, err := gitLines(path, "log", "--walk-reflogs", "--format=%H %P", "refs/stash")
+	if err != nil {
+		return nil, err
+	}
+	var commits []string
+	for _, line := range lines {
+		// The first parent is the commit the entry was made on, which is part
+		// of the history being scanned.
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		commits = append(commits, fields[0])
+		commits = append(commits, fields[2:]...)
+	}
+	return commits, nil
+}
+
+// gitLines runs git with args in the repository at path and returns the
+// non-empty lines of its output.
+func gitLines(path string, args ...string) ([]string, error) {
+	out, err := gitOutput(path, nil, args...)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, line := range strings.Split(out, "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// gitFields runs git with args in the repository at path, with stdin as its
+// standard input, and returns the whitespace separated fields of its output.
+func gitFields(path string, stdin *strings.Reader, args ...string) ([]string, error) {
+	out, err := gitOutput(path, stdin, args...)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(out), nil
+}
+
+// gitOutput runs git with args in the repository at path, with stdin as its
+// standard input, and returns its output. The output is also returned along
+// with the error if git fails.
+func gitOutput(path string, stdin *strings.Reader, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", path}, args...)...)
+	if stdin != nil {
+		cmd.Stdin = stdin
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return string(out), fmt.Errorf("error running git %s: %w\n%s", args[0], err, stderr.Bytes())
+	}
+	return string(out), nil
+}
+
+// objectClassReporter records the object class in the git metadata of the
+// chunks it reports.
+type objectClassReporter struct {
+	sources.ChunkReporter
+	class ObjectClass
+}
+
+func (r objectClassReporter) ChunkOk(ctx context.Context, chunk sources.Chunk) error {
+	if meta := chunk.SourceMetadata.GetGit(); meta != nil {
+		meta.ObjectClass = string(r.class)
+	}
+	return r.ChunkReporter.ChunkOk(ctx, chunk)
+}
@@ -13,8 +13,42 @@ type ScanOptions struct {
 	Bare         bool
 	ExcludeGlobs []string
 	LogOptions   *git.LogOptions
+	// Revisions, if set, select the commits to scan in place of HeadHash
+	// and BaseHash. They are passed to `git log` as-is, e.g.
+	// []string{"<new>", "^<old>"} or []string{"<new>", "--not", "--all"}.
+	Revisions []string
+	// Ranges, if set, select the commits to scan in place of HeadHash and
+	// BaseHash as the union of `git rev-list` ranges like "main..feature".
+	// Commits shared between ranges are scanned once.
+	Ranges []string
+	// StagedOnly scans the staged changes of the repository and nothing else.
+	StagedOnly bool
+	// ObjectClasses are the classes of objects outside the history of the
+	// repository's refs to scan in addition to it.
+	ObjectClasses []ObjectClass
+
+	// commits, if set, are the exact commits to scan, without their history.
+	commits []string
 }
 
+// ObjectClass is a class of git objects that are not part of the history of
+// a repository's refs, but may still hold secrets, e.g. after a rebase. The
+// class is recorded in the metadata of the chunks scanned from its objects.
+type ObjectClass string
+
+const (
+	// ObjectClassTag is the message of an annotated tag.
+	ObjectClassTag ObjectClass = "tag"
+	// ObjectClassStash is a stash entry, including its index and untracked
+	// files.
+	ObjectClassStash ObjectClass = "stash"
+	// ObjectClassReflog is a commit only reachable from a reflog.
+	ObjectClassReflog ObjectClass = "reflog"
+	// ObjectClassDangling is an unreachable commit, blob or tag, as
+	// recovered by `git fsck --lost-found`.
+	ObjectClassDangling ObjectClass = "dangling"
+)
+
 type ScanOption func(*ScanOptions)
 
 func ScanOptionFilter(filter *common.Filter) ScanOption {
@@ -59,6 +93,30 @@ func ScanOptionBare(bare bool) ScanOption {
 	}
 }
 
+func ScanOptionRevisions(revisions []string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Revisions = revisions
+	}
+}
+
+func ScanOptionRanges(ranges []string) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.Ranges = ranges
+	}
+}
+
+func ScanOptionStagedOnly(stagedOnly bool) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.StagedOnly = stagedOnly
+	}
+}
+
+func ScanOptionObjectClasses(classes []ObjectClass) ScanOption {
+	return func(scanOptions *ScanOptions) {
+		scanOptions.ObjectClasses = classes
+	}
+}
+
 func NewScanOptions(options ...ScanOption) *ScanOptions {
 	scanOptions := &ScanOptions{
 		Filter:   common.FilterEmpty(),
@@ -0,0 +1,113 @@
+package git
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ScanState records the tip commit of the last successful scan of each
+// repository and ref, so that later scans only cover the commits added since.
+// It is persisted as a JSON file.
+type ScanState struct {
+	mu   sync.Mutex
+	path string
+	// Repositories maps a normalized repository URL to the last scanned
+	// commit of each of its refs.
+	Repositories map[string]map[string]string `json:"repositories"`
+}
+
+// LoadScanState reads the scan state stored at path. A missing file yields an
+// empty state, which is created on the first Save.
+func LoadScanState(path string) (*ScanState, error) {
+	state := &ScanState{path: path, Repositories: make(map[string]map[string]string)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading scan state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("error parsing scan state %s: %w", path, err)
+	}
+	if state.Repositories == nil {
+		state.Repositories = make(map[string]map[string]string)
+	}
+	return state, nil
+}
+
+// LastScanned returns the last scanned commit of the repository's ref, or an
+// empty string if it was never scanned.
+func (s *ScanState) LastScanned(repo, ref string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.Repositories[repo][ref]
+}
+
+// SetLastScanned records commit as the last scanned commit of the
+// repository's ref.
+func (s *ScanState) SetLastScanned(repo, ref, commit string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.Repositories[repo] == nil {
+		s.Repositories[repo] = make(map[string]string)
+	}
+	s.Repositories[repo][ref] = commit
+}
+
+// Save writes the scan state to its file. The file is replaced atomically, so
+// an interrupted save never leaves a truncated state behind.
+func (s *ScanState) Save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("error saving scan state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error saving scan state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error saving scan state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("error saving scan state: %w", err)
+	}
+	return nil
+}
+
+// scanStateKey normalizes a repository URI into its key in the scan state, so
+// that the same repository is found regardless of credentials, letter case of
+// the host, or a trailing ".git". Local repositories are keyed by their
+// absolute path.
+func scanStateKey(uri string) (string, error) {
+	parsed, err := GitURLParse(uri)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme == "file" {
+		path, err := filepath.Abs(parsed.Host + parsed.Path)
+		if err != nil {
+			return "", err
+		}
+		return "file://" + path, nil
+	}
+
+	parsed.User = nil
+	parsed.Host = strings.ToLower(parsed.Host)
+	parsed.Path = strings.TrimSuffix(strings.TrimSuffix(parsed.Path, "/"), ".git") + ".git"
+	parsed.RawQuery, parsed.Fragment = "", ""
+	return parsed.String(), nil
+}
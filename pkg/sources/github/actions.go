@@ -0,0 +1,336 @@
+package github
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v67/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// defaultMaxActionsDownloadSize is the size above which workflow run log
+// archives and artifacts are skipped if no other limit is configured.
+const defaultMaxActionsDownloadSize = 250 * 1024 * 1024 // 250 MiB
+
+// errDownloadTooLarge is returned by downloadFile for files larger than the
+// given maximum size.
+var errDownloadTooLarge = errors.New("download exceeds the maximum size")
+
+// setMaxActionsDownloadSize sets the maximum size of workflow run log archives
+// and artifacts that will be scanned. If not set or set to a negative number,
+// the defaultMaxActionsDownloadSize will be used.
+func (s *Source) setMaxActionsDownloadSize(maxSize int64) {
+	if maxSize <= 0 {
+		s.maxActionsDownloadSize = defaultMaxActionsDownloadSize
+	} else {
+		s.maxActionsDownloadSize = maxSize
+	}
+}
+
+// scanActions scans the logs and artifacts of the repository's workflow runs,
+// as enabled. Runs created before the actions timeframe are skipped.
+func (s *Source) scanActions(ctx context.Context, repoURL string, repoInfo repoInfo, reporter sources.ChunkReporter) error {
+	_, urlParts, err := getRepoURLParts(repoURL)
+	if err != nil {
+		return err
+	}
+	if isGistUrl(urlParts) {
+		// Gists have no workflow runs.
+		return nil
+	}
+
+	opts := &github.ListWorkflowRunsOptions{
+		ListOptions: github.ListOptions{
+			PerPage: defaultPagination,
+			Page:    initialPage,
+		},
+	}
+	if s.conn.ActionsTimeframeDays > 0 {
+		cutoff := time.Now().AddDate(0, 0, -int(s.conn.ActionsTimeframeDays))
+		opts.Created = ">=" + cutoff.Format(time.DateOnly)
+	}
+
+	for {
+		runs, _, err := s.connector.APIClient().Actions.ListRepositoryWorkflowRuns(ctx, repoInfo.owner, repoInfo.name, opts)
+		if s.handleRateLimitWithChunkReporter(ctx, reporter, err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, run := range runs.WorkflowRuns {
+			runCtx := context.WithValue(ctx, "workflow_run_id", run.GetID())
+			if err := s.scanWorkflowRun(runCtx, repoInfo, run, reporter); err != nil {
+				return err
+			}
+		}
+
+		opts.ListOptions.Page++
+		if len(runs.WorkflowRuns) < defaultPagination {
+			break
+		}
+	}
+	return nil
+}
+
+// scanWorkflowRun scans the logs and artifacts of a single workflow run. A
+// failure to scan either is reported without stopping the scan of the others.
+func (s *Source) scanWorkflowRun(ctx context.Context, repoInfo repoInfo, run *github.WorkflowRun, reporter sources.ChunkReporter) error {
+	if s.conn.IncludeActionsLogs {
+		if err := s.scanWorkflowRunLogs(ctx, repoInfo, run, reporter); err != nil {
+			err := fmt.Errorf("error scanning logs of workflow run %d: %w", run.GetID(), err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	if s.conn.IncludeActionsArtifacts {
+		if err := s.scanWorkflowRunArtifacts(ctx, repoInfo, run, reporter); err != nil {
+			err := fmt.Errorf("error scanning artifacts of workflow run %d: %w", run.GetID(), err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanWorkflowRunLogs downloads the log archive of a workflow run and scans
+// each of its step logs.
+func (s *Source) scanWorkflowRunLogs(ctx context.Context, repoInfo repoInfo, run *github.WorkflowRun, reporter sources.ChunkReporter) error {
+	u := fmt.Sprintf("repos/%s/%s/actions/runs/%d/logs", repoInfo.owner, repoInfo.name, run.GetID())
+	tmp, size, err := s.downloadFile(ctx, u, "", s.maxActionsDownloadSize, reporter)
+	if isGitHub404Error(err) {
+		// Logs are deleted once the repository's retention period ends.
+		ctx.Logger().V(3).Info("workflow run logs are not available")
+		return nil
+	}
+	if errors.Is(err, errDownloadTooLarge) {
+		ctx.Logger().V(5).Info("Skipping large workflow run log archive", "max_actions_download_size", s.maxActionsDownloadSize)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	archive, err := zip.NewReader(tmp, size)
+	if err != nil {
+		return fmt.Errorf("error reading log archive: %w", err)
+	}
+
+	for _, entry := range workflowLogEntries(archive.File) {
+		chunkSkel := s.workflowRunChunk(repoInfo, run)
+		meta := chunkSkel.SourceMetadata.GetGithub()
+		meta.File = sanitizer.UTF8(entry.file.Name)
+		meta.WorkflowJob = sanitizer.UTF8(entry.job)
+		meta.WorkflowStep = sanitizer.UTF8(entry.step)
+
+		logCtx := context.WithValues(ctx, "workflow_job", entry.job, "workflow_step", entry.step)
+		rc, err := entry.file.Open()
+		if err != nil {
+			return fmt.Errorf("error reading log %s: %w", entry.file.Name, err)
+		}
+		err = handlers.HandleFile(logCtx, rc, chunkSkel, reporter)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// workflowLogEntry is a log file of a workflow run log archive.
+type workflowLogEntry struct {
+	file *zip.File
+	job  string
+	step string
+}
+
+// workflowLogEntries returns the log files of a workflow run log archive
+// along with the job and step they belong to. Archives hold a directory of
+// step logs named "<n>_<step>.txt" per job, and a "<n>_<job>.txt" file with
+// the whole log of each job. The latter is only used for jobs without step
+// logs, so that every line is scanned once.
+func workflowLogEntries(files []*zip.File) []workflowLogEntry {
+	jobsWithSteps := make(map[string]struct{})
+	for _, f := range files {
+		if dir, _ := path.Split(f.Name); dir != "" {
+			jobsWithSteps[strings.TrimSuffix(dir, "/")] = struct{}{}
+		}
+	}
+
+	var entries []workflowLogEntry
+	for _, f := range files {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		dir, name := path.Split(f.Name)
+		name = trimLogOrdinal(strings.TrimSuffix(name, ".txt"))
+		if dir != "" {
+			entries = append(entries, workflowLogEntry{file: f, job: strings.TrimSuffix(dir, "/"), step: name})
+			continue
+		}
+		if _, ok := jobsWithSteps[name]; ok {
+			continue
+		}
+		entries = append(entries, workflowLogEntry{file: f, job: name})
+	}
+	return entries
+}
+
+// trimLogOrdinal removes the "<n>_" prefix that orders the files of a log
+// archive.
+func trimLogOrdinal(name string) string {
+	ordinal, rest, ok := strings.Cut(name, "_")
+	if !ok {
+		return name
+	}
+	if _, err := strconv.Atoi(ordinal); err != nil {
+		return name
+	}
+	return rest
+}
+
+// scanWorkflowRunArtifacts scans the artifacts uploaded by a workflow run that
+// have not expired yet.
+func (s *Source) scanWorkflowRunArtifacts(ctx context.Context, repoInfo repoInfo, run *github.WorkflowRun, reporter sources.ChunkReporter) error {
+	opts := &github.ListOptions{PerPage: defaultPagination, Page: initialPage}
+	for {
+		artifacts, _, err := s.connector.APIClient().Actions.ListWorkflowRunArtifacts(ctx, repoInfo.owner, repoInfo.name, run.GetID(), opts)
+		if s.handleRateLimitWithChunkReporter(ctx, reporter, err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, artifact := range artifacts.Artifacts {
+			if artifact.GetExpired() {
+				continue
+			}
+			if artifact.GetSizeInBytes() > s.maxActionsDownloadSize {
+				ctx.Logger().V(5).Info("Skipping large artifact", "artifact", artifact.GetName(), "max_actions_download_size", s.maxActionsDownloadSize)
+				continue
+			}
+			artifactCtx := context.WithValue(ctx, "artifact", artifact.GetName())
+			if err := s.scanWorkflowRunArtifact(artifactCtx, repoInfo, run, artifact, reporter); err != nil {
+				err := fmt.Errorf("error scanning artifact %s: %w", artifact.GetName(), err)
+				if err := reporter.ChunkErr(ctx, err); err != nil {
+					return err
+				}
+			}
+		}
+
+		opts.Page++
+		if len(artifacts.Artifacts) < defaultPagination {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanWorkflowRunArtifact(ctx context.Context, repoInfo repoInfo, run *github.WorkflowRun, artifact *github.Artifact, reporter sources.ChunkReporter) error {
+	u := fmt.Sprintf("repos/%s/%s/actions/artifacts/%d/zip", repoInfo.owner, repoInfo.name, artifact.GetID())
+	tmp, _, err := s.downloadFile(ctx, u, "", s.maxActionsDownloadSize, reporter)
+	if errors.Is(err, errDownloadTooLarge) {
+		ctx.Logger().V(5).Info("Skipping large artifact", "max_actions_download_size", s.maxActionsDownloadSize)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	chunkSkel := s.workflowRunChunk(repoInfo, run)
+	meta := chunkSkel.SourceMetadata.GetGithub()
+	meta.File = sanitizer.UTF8(artifact.GetName())
+	meta.Link = sanitizer.UTF8(fmt.Sprintf("%s/artifacts/%d", run.GetHTMLURL(), artifact.GetID()))
+	return handlers.HandleFile(ctx, tmp, chunkSkel, reporter)
+}
+
+// workflowRunChunk returns the chunk skeleton for the files of a workflow run.
+func (s *Source) workflowRunChunk(repoInfo repoInfo, run *github.WorkflowRun) *sources.Chunk {
+	return &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: &source_metadatapb.Github{
+					Link:          sanitizer.UTF8(run.GetHTMLURL()),
+					Username:      sanitizer.UTF8(run.GetActor().GetLogin()),
+					Repository:    sanitizer.UTF8(repoInfo.fullName),
+					Commit:        sanitizer.UTF8(run.GetHeadSHA()),
+					Timestamp:     sanitizer.UTF8(run.GetCreatedAt().String()),
+					Visibility:    repoInfo.visibility,
+					WorkflowRunId: run.GetID(),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+}
+
+// downloadFile downloads a log archive, artifact or release asset from the
+// API endpoint u into a temporary file, which the caller must remove. If set,
+// mediaType replaces the Accept header of the request. The API redirects to a
+// short-lived download URL, which the client follows without forwarding its
+// credentials. The file is downloaded before it is scanned so that slow scans
+// don't count against the client's timeout. Files larger than maxSize are
+// not kept and errDownloadTooLarge is returned.
+func (s *Source) downloadFile(ctx context.Context, u, mediaType string, maxSize int64, reporter sources.ChunkReporter) (*os.File, int64, error) {
+	for {
+		req, err := s.connector.APIClient().NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		if mediaType != "" {
+			req.Header.Set("Accept", mediaType)
+		}
+		resp, err := s.connector.APIClient().BareDo(ctx, req)
+		if s.handleRateLimitWithChunkReporter(ctx, reporter, err) {
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		defer resp.Body.Close()
+
+		tmp, err := os.CreateTemp("", "trufflehog-github-*")
+		if err != nil {
+			return nil, 0, err
+		}
+		// Read one byte past the limit to tell whether the file is larger.
+		size, err := io.Copy(tmp, io.LimitReader(resp.Body, maxSize+1))
+		if err == nil && size > maxSize {
+			err = errDownloadTooLarge
+		}
+		if err == nil {
+			_, err = tmp.Seek(0, io.SeekStart)
+		}
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+			return nil, 0, fmt.Errorf("error downloading %s: %w", u, err)
+		}
+		return tmp, size, nil
+	}
+}
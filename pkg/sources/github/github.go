@@ -74,6 +74,9 @@ type Source struct {
 	includeGistComments   bool
 	commentsTimeframeDays uint32
 
+	maxReleaseAssetSize    int64
+	maxActionsDownloadSize int64
+
 	sources.Progress
 	sources.CommonSourceUnitUnmarshaller
 
@@ -265,6 +268,8 @@ func (s *Source) Init(aCtx context.Context, name string, jobID sources.JobID, so
 	s.includePRComments = s.conn.IncludePullRequestComments
 	s.includeGistComments = s.conn.IncludeGistComments
 	s.commentsTimeframeDays = s.conn.CommentsTimeframeDays
+	s.setMaxReleaseAssetSize(s.conn.GetMaxReleaseAssetSize())
+	s.setMaxActionsDownloadSize(s.conn.GetMaxActionsDownloadSize())
 
 	// Head or base should only be used with incoming webhooks
 	if (len(s.conn.Head) > 0 || len(s.conn.Base) > 0) && len(s.repos) != 1 {
@@ -727,6 +732,26 @@ func (s *Source) scanRepo(ctx context.Context, repoURL string, reporter sources.
 		}
 	}
 
+	// Scan GitHub Actions workflow runs, if enabled.
+	if s.conn.IncludeActionsLogs || s.conn.IncludeActionsArtifacts {
+		if err := s.scanActions(ctx, repoURL, repoInfo, reporter); err != nil {
+			err := fmt.Errorf("error scanning actions: %w", err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Scan releases, if enabled.
+	if s.conn.IncludeReleases {
+		if err := s.scanReleases(ctx, repoURL, repoInfo, reporter); err != nil {
+			err := fmt.Errorf("error scanning releases: %w", err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+
 	ctx.Logger().V(2).Info("finished scanning repo", "duration_seconds", duration)
 	githubReposScanned.WithLabelValues(s.name).Inc()
 	return nil
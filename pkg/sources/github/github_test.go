@@ -1,6 +1,7 @@
 package github
 
 import (
+	"archive/zip"
 	"bytes"
 	"crypto/rand"
 	"crypto/rsa"
@@ -29,6 +30,7 @@ import (
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/credentialspb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
 )
 
 func createPrivateKey() string {
@@ -966,3 +968,197 @@ func noopReporter() sources.UnitReporter {
 		},
 	}
 }
+
+func TestScanActions(t *testing.T) {
+	defer gock.Off()
+
+	var logs bytes.Buffer
+	zw := zip.NewWriter(&logs)
+	for name, content := range map[string]string{
+		"0_build.txt":            "whole build log",
+		"build/1_Set up job.txt": "set up job",
+		"build/2_Run tests.txt":  "AWS_SECRET_ACCESS_KEY=secret",
+		"1_lint.txt":             "lint log",
+	} {
+		w, err := zw.Create(name)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, zw.Close())
+
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/runs").
+		MatchParam("created", `^>=\d{4}-\d{2}-\d{2}$`).
+		Reply(200).
+		JSON(map[string]any{"total_count": 1, "workflow_runs": []map[string]any{
+			{"id": 42, "head_sha": "abc123", "html_url": "https://github.com/super-secret-user/super-secret-repo/actions/runs/42"},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/runs/42/logs").
+		Reply(http.StatusFound).
+		SetHeader("Location", "https://pipelines.example.com/logs/42.zip")
+	gock.New("https://pipelines.example.com").
+		Get("/logs/42.zip").
+		Reply(200).
+		Body(bytes.NewReader(logs.Bytes()))
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/runs/42/artifacts").
+		Reply(200).
+		JSON(map[string]any{"total_count": 3, "artifacts": []map[string]any{
+			{"id": 7, "name": "dist", "expired": false, "size_in_bytes": 13},
+			{"id": 8, "name": "old-dist", "expired": true},
+			{"id": 9, "name": "huge-dist", "expired": false, "size_in_bytes": 1 << 20},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/artifacts/7/zip").
+		Reply(http.StatusFound).
+		SetHeader("Location", "https://pipelines.example.com/artifacts/7")
+	gock.New("https://pipelines.example.com").
+		Get("/artifacts/7").
+		Reply(200).
+		BodyString(".env contents")
+
+	s := initTestSource(&sourcespb.GitHub{
+		Credential: &sourcespb.GitHub_Token{
+			Token: "super secret token",
+		},
+		IncludeActionsLogs:      true,
+		IncludeActionsArtifacts: true,
+		ActionsTimeframeDays:    30,
+		MaxActionsDownloadSize:  64 * 1024,
+	})
+
+	repoInfo := repoInfo{owner: "super-secret-user", name: "super-secret-repo", fullName: "super-secret-user/super-secret-repo"}
+	chunksChan := make(chan *sources.Chunk, 10)
+	err := s.scanActions(context.Background(), "https://github.com/super-secret-user/super-secret-repo.git", repoInfo, sources.ChanReporter{Ch: chunksChan})
+	assert.Nil(t, err)
+	close(chunksChan)
+
+	type chunkMeta struct {
+		Data, File, Job, Step, Link string
+	}
+	var got []chunkMeta
+	for chunk := range chunksChan {
+		meta := chunk.SourceMetadata.GetGithub()
+		assert.Equal(t, int64(42), meta.GetWorkflowRunId())
+		assert.Equal(t, "abc123", meta.GetCommit())
+		got = append(got, chunkMeta{string(chunk.Data), meta.GetFile(), meta.GetWorkflowJob(), meta.GetWorkflowStep(), meta.GetLink()})
+	}
+	runURL := "https://github.com/super-secret-user/super-secret-repo/actions/runs/42"
+	want := []chunkMeta{
+		{"set up job", "build/1_Set up job.txt", "build", "Set up job", runURL},
+		{"AWS_SECRET_ACCESS_KEY=secret", "build/2_Run tests.txt", "build", "Run tests", runURL},
+		{"lint log", "1_lint.txt", "lint", "", runURL},
+		{".env contents", "dist", "", "", runURL + "/artifacts/7"},
+	}
+	assert.ElementsMatch(t, want, got)
+	assert.False(t, gock.HasUnmatchedRequest())
+	assert.True(t, gock.IsDone())
+}
+
+func TestScanActionsSkipsLargeDownloads(t *testing.T) {
+	defer gock.Off()
+
+	large := strings.Repeat("x", 2048)
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/runs").
+		Reply(200).
+		JSON(map[string]any{"total_count": 1, "workflow_runs": []map[string]any{
+			{"id": 42, "head_sha": "abc123", "html_url": "https://github.com/super-secret-user/super-secret-repo/actions/runs/42"},
+		}})
+	// Log archives have no size in the API, so the limit applies as they are
+	// downloaded.
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/runs/42/logs").
+		Reply(200).
+		BodyString(large)
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/runs/42/artifacts").
+		Reply(200).
+		JSON(map[string]any{"total_count": 2, "artifacts": []map[string]any{
+			{"id": 7, "name": "dist", "expired": false, "size_in_bytes": 2048},
+			{"id": 8, "name": "misreported", "expired": false, "size_in_bytes": 12},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/actions/artifacts/8/zip").
+		Reply(200).
+		BodyString(large)
+
+	s := initTestSource(&sourcespb.GitHub{
+		Credential: &sourcespb.GitHub_Token{
+			Token: "super secret token",
+		},
+		IncludeActionsLogs:      true,
+		IncludeActionsArtifacts: true,
+		MaxActionsDownloadSize:  1024,
+	})
+
+	repoInfo := repoInfo{owner: "super-secret-user", name: "super-secret-repo", fullName: "super-secret-user/super-secret-repo"}
+	reporter := sourcestest.TestReporter{}
+	err := s.scanActions(context.Background(), "https://github.com/super-secret-user/super-secret-repo.git", repoInfo, &reporter)
+	assert.Nil(t, err)
+
+	assert.Empty(t, reporter.Chunks)
+	assert.Empty(t, reporter.ChunkErrs)
+	assert.False(t, gock.HasUnmatchedRequest())
+	assert.True(t, gock.IsDone())
+}
+
+func TestScanReleases(t *testing.T) {
+	defer gock.Off()
+
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/releases").
+		Reply(200).
+		JSON([]map[string]any{{
+			"tag_name": "v1.0.0",
+			"name":     "v1.0.0",
+			"body":     "release notes",
+			"html_url": "https://github.com/super-secret-user/super-secret-repo/releases/tag/v1.0.0",
+			"assets": []map[string]any{
+				{"id": 5, "name": "dist.env", "size": 12, "browser_download_url": "https://github.com/super-secret-user/super-secret-repo/releases/download/v1.0.0/dist.env"},
+				{"id": 6, "name": "huge.tar.gz", "size": 2048, "browser_download_url": "https://github.com/super-secret-user/super-secret-repo/releases/download/v1.0.0/huge.tar.gz"},
+			},
+		}})
+	gock.New("https://api.github.com").
+		Get("/repos/super-secret-user/super-secret-repo/releases/assets/5").
+		MatchHeader("Accept", "application/octet-stream").
+		Reply(http.StatusFound).
+		SetHeader("Location", "https://objects.example.com/assets/5")
+	gock.New("https://objects.example.com").
+		Get("/assets/5").
+		Reply(200).
+		BodyString("TOKEN=secret")
+
+	s := initTestSource(&sourcespb.GitHub{
+		Credential: &sourcespb.GitHub_Token{
+			Token: "super secret token",
+		},
+		IncludeReleases:     true,
+		MaxReleaseAssetSize: 1024,
+	})
+
+	repoInfo := repoInfo{owner: "super-secret-user", name: "super-secret-repo", fullName: "super-secret-user/super-secret-repo"}
+	chunksChan := make(chan *sources.Chunk, 10)
+	err := s.scanReleases(context.Background(), "https://github.com/super-secret-user/super-secret-repo.git", repoInfo, sources.ChanReporter{Ch: chunksChan})
+	assert.Nil(t, err)
+	close(chunksChan)
+
+	type chunkMeta struct {
+		Data, File, Link string
+	}
+	var got []chunkMeta
+	for chunk := range chunksChan {
+		meta := chunk.SourceMetadata.GetGithub()
+		assert.Equal(t, "v1.0.0", meta.GetReleaseTag())
+		got = append(got, chunkMeta{string(chunk.Data), meta.GetFile(), meta.GetLink()})
+	}
+	want := []chunkMeta{
+		{"v1.0.0\nrelease notes", "", "https://github.com/super-secret-user/super-secret-repo/releases/tag/v1.0.0"},
+		{"TOKEN=secret", "dist.env", "https://github.com/super-secret-user/super-secret-repo/releases/download/v1.0.0/dist.env"},
+	}
+	assert.Equal(t, want, got)
+	assert.False(t, gock.HasUnmatchedRequest())
+	assert.True(t, gock.IsDone())
+}
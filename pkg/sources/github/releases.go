@@ -0,0 +1,142 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/google/go-github/v67/github"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const (
+	defaultMaxReleaseAssetSize = 250 * 1024 * 1024      // 250 MiB
+	maxReleaseAssetSizeLimit   = 2 * 1024 * 1024 * 1024 // 2 GiB, the largest asset GitHub accepts
+)
+
+// setMaxReleaseAssetSize sets the maximum size of release assets that will be
+// scanned. If not set, set to a negative number, or set larger than the
+// maxReleaseAssetSizeLimit, the defaultMaxReleaseAssetSize will be used.
+func (s *Source) setMaxReleaseAssetSize(maxAssetSize int64) {
+	if maxAssetSize <= 0 || maxAssetSize > maxReleaseAssetSizeLimit {
+		s.maxReleaseAssetSize = defaultMaxReleaseAssetSize
+	} else {
+		s.maxReleaseAssetSize = maxAssetSize
+	}
+}
+
+// scanReleases scans the name and notes of each release of the repository,
+// along with its uploaded assets.
+func (s *Source) scanReleases(ctx context.Context, repoURL string, repoInfo repoInfo, reporter sources.ChunkReporter) error {
+	_, urlParts, err := getRepoURLParts(repoURL)
+	if err != nil {
+		return err
+	}
+	if isGistUrl(urlParts) {
+		// Gists have no releases.
+		return nil
+	}
+
+	opts := &github.ListOptions{PerPage: defaultPagination, Page: initialPage}
+	for {
+		releases, _, err := s.connector.APIClient().Repositories.ListReleases(ctx, repoInfo.owner, repoInfo.name, opts)
+		if s.handleRateLimitWithChunkReporter(ctx, reporter, err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, release := range releases {
+			releaseCtx := context.WithValue(ctx, "release", release.GetTagName())
+			if err := s.scanRelease(releaseCtx, repoInfo, release, reporter); err != nil {
+				return err
+			}
+		}
+
+		opts.Page++
+		if len(releases) < defaultPagination {
+			break
+		}
+	}
+	return nil
+}
+
+// scanRelease scans a release and its assets. A failure to scan an asset is
+// reported without stopping the scan of the others.
+func (s *Source) scanRelease(ctx context.Context, repoInfo repoInfo, release *github.RepositoryRelease, reporter sources.ChunkReporter) error {
+	chunk := s.releaseChunk(repoInfo, release)
+	chunk.Data = []byte(sanitizer.UTF8(release.GetName() + "\n" + release.GetBody()))
+	if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+		return err
+	}
+
+	for _, asset := range release.Assets {
+		if int64(asset.GetSize()) > s.maxReleaseAssetSize {
+			ctx.Logger().V(5).Info("Skipping large release asset", "asset", asset.GetName(), "max_release_asset_size", s.maxReleaseAssetSize)
+			continue
+		}
+		assetCtx := context.WithValue(ctx, "asset", asset.GetName())
+		if err := s.scanReleaseAsset(assetCtx, repoInfo, release, asset, reporter); err != nil {
+			err := fmt.Errorf("error scanning release asset %s: %w", asset.GetName(), err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) scanReleaseAsset(ctx context.Context, repoInfo repoInfo, release *github.RepositoryRelease, asset *github.ReleaseAsset, reporter sources.ChunkReporter) error {
+	u := fmt.Sprintf("repos/%s/%s/releases/assets/%d", repoInfo.owner, repoInfo.name, asset.GetID())
+	tmp, _, err := s.downloadFile(ctx, u, "application/octet-stream", s.maxReleaseAssetSize, reporter)
+	if errors.Is(err, errDownloadTooLarge) {
+		ctx.Logger().V(5).Info("Skipping large release asset", "max_release_asset_size", s.maxReleaseAssetSize)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	chunkSkel := s.releaseChunk(repoInfo, release)
+	meta := chunkSkel.SourceMetadata.GetGithub()
+	meta.File = sanitizer.UTF8(asset.GetName())
+	meta.Link = sanitizer.UTF8(asset.GetBrowserDownloadURL())
+	return handlers.HandleFile(ctx, tmp, chunkSkel, reporter)
+}
+
+// releaseChunk returns the chunk skeleton for the notes and assets of a
+// release.
+func (s *Source) releaseChunk(repoInfo repoInfo, release *github.RepositoryRelease) *sources.Chunk {
+	timestamp := release.GetPublishedAt()
+	if timestamp.IsZero() {
+		// Drafts are not published yet.
+		timestamp = release.GetCreatedAt()
+	}
+	return &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Github{
+				Github: &source_metadatapb.Github{
+					Link:       sanitizer.UTF8(release.GetHTMLURL()),
+					Username:   sanitizer.UTF8(release.GetAuthor().GetLogin()),
+					Repository: sanitizer.UTF8(repoInfo.fullName),
+					Timestamp:  sanitizer.UTF8(timestamp.String()),
+					Visibility: repoInfo.visibility,
+					ReleaseTag: sanitizer.UTF8(release.GetTagName()),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+}
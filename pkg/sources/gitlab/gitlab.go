@@ -52,6 +52,17 @@ type Source struct {
 	// organizations that have lots of group sharing.
 	enumerateSharedProjects bool
 
+	includeIssueComments        bool
+	includeMergeRequestComments bool
+	includeSnippets             bool
+	includeWikis                bool
+	commentsTimeframeDays       uint32
+
+	includePipelineLogs      bool
+	includePipelineArtifacts bool
+	pipelinesTimeframeDays   uint32
+	maxPipelineDownloadSize  int64
+
 	useCustomContentWriter bool
 	git                    *git.Git
 	scanOptions            *git.ScanOptions
@@ -163,6 +174,18 @@ func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sou
 	s.ignoreRepos = conn.GetIgnoreRepos()
 	s.includeRepos = conn.GetIncludeRepos()
 	s.enumerateSharedProjects = !conn.ExcludeProjectsSharedIntoGroups
+	s.includeIssueComments = conn.GetIncludeIssueComments()
+	s.includeMergeRequestComments = conn.GetIncludeMergeRequestComments()
+	s.includeSnippets = conn.GetIncludeSnippets()
+	s.includeWikis = conn.GetIncludeWikis()
+	s.commentsTimeframeDays = conn.GetCommentsTimeframeDays()
+	s.includePipelineLogs = conn.GetIncludePipelineLogs()
+	s.includePipelineArtifacts = conn.GetIncludePipelineArtifacts()
+	s.pipelinesTimeframeDays = conn.GetPipelinesTimeframeDays()
+	s.maxPipelineDownloadSize = conn.GetMaxPipelineDownloadSize()
+	if s.maxPipelineDownloadSize <= 0 {
+		s.maxPipelineDownloadSize = defaultMaxPipelineDownloadSize
+	}
 
 	// configuration uses the inverse logic of the `useAuthInUrl` flag.
 	s.useAuthInUrl = !conn.RemoveAuthInUrl
@@ -272,6 +295,11 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, tar
 		if err := s.listProjects(ctx, apiClient, ignoreRepo, reporter); err != nil {
 			return err
 		}
+		if s.includeSnippets && len(s.groupIds) == 0 {
+			if err := s.listSnippets(ctx, apiClient, reporter); err != nil {
+				return err
+			}
+		}
 
 	} else {
 		gitlabReposEnumerated.WithLabelValues(s.name).Set(float64(len(repos)))
@@ -281,7 +309,7 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, tar
 	// We must sort the repos so we can resume later if necessary.
 	slices.Sort(s.repos)
 
-	return s.scanRepos(ctx, chunksChan)
+	return s.scanRepos(ctx, apiClient, chunksChan)
 }
 
 func (s *Source) listProjects(ctx context.Context,
@@ -844,7 +872,7 @@ func (s *Source) getAllProjectReposInGroups(
 	return nil
 }
 
-func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk) error {
+func (s *Source) scanRepos(ctx context.Context, apiClient *gitlab.Client, chunksChan chan *sources.Chunk) error {
 	// If there is resume information available, limit this scan to only the repos that still need scanning.
 	reposToScan, progressIndexOffset := sources.FilterReposToResume(s.repos, s.GetProgress().EncodedResumeInfo)
 	ctx.Logger().V(2).Info("filtered repos to resume", "before", len(s.repos), "after", len(reposToScan))
@@ -875,29 +903,8 @@ func (s *Source) scanRepos(ctx context.Context, chunksChan chan *sources.Chunk)
 				s.resumeInfoSlice = sources.RemoveRepoFromResumeInfo(s.resumeInfoSlice, repoURL)
 			}(s)
 
-			var path string
-			var repo *gogit.Repository
-			var err error
-			if s.authMethod == "UNAUTHENTICATED" {
-				path, repo, err = git.CloneRepoUsingUnauthenticated(ctx, repoURL)
-			} else {
-				// If a username is not provided we need to use a default one in order to clone a private repo.
-				// Not setting "placeholder" as s.user on purpose in case any downstream services rely on a "" value for s.user.
-				user := s.user
-				if user == "" {
-					user = "placeholder"
-				}
-
-				path, repo, err = git.CloneRepoUsingToken(ctx, s.token, repoURL, user, s.useAuthInUrl)
-			}
-			if err != nil {
-				scanErrs.Add(err)
-				return nil
-			}
-			defer os.RemoveAll(path)
-
 			logger.V(2).Info("starting scan", "num", i+1, "total", len(s.repos))
-			if err = s.git.ScanRepo(ctx, repo, path, s.scanOptions, sources.ChanReporter{Ch: chunksChan}); err != nil {
+			if err := s.scanRepo(ctx, apiClient, repoURL, sources.ChanReporter{Ch: chunksChan}); err != nil {
 				scanErrs.Add(err)
 				return nil
 			}
@@ -1057,6 +1064,10 @@ func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) e
 		return err
 	}
 
+	if s.includeSnippets && len(s.groupIds) == 0 {
+		return s.listSnippets(ctx, apiClient, reporter)
+	}
+
 	return nil
 }
 
@@ -1064,6 +1075,40 @@ func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) e
 func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
 	repoURL, _ := unit.SourceUnitID()
 
+	// The API client is only needed to scan the content of the project
+	// besides its repository.
+	var apiClient *gitlab.Client
+	if s.includesProjectContent() && !isSnippetURL(repoURL) {
+		var err error
+		if apiClient, err = s.newClient(); err != nil {
+			return err
+		}
+	}
+
+	return s.scanRepo(ctx, apiClient, repoURL, reporter)
+}
+
+// scanRepo clones and scans the repository at repoURL, then the content of
+// its project if configured. An error is returned if the repository could not
+// be cloned or scanned, otherwise all errors are reported to the
+// ChunkReporter.
+func (s *Source) scanRepo(ctx context.Context, apiClient *gitlab.Client, repoURL string, reporter sources.ChunkReporter) error {
+	if err := s.cloneAndScanRepo(ctx, repoURL, reporter); err != nil {
+		return err
+	}
+
+	if !s.includesProjectContent() || isSnippetURL(repoURL) {
+		return nil
+	}
+	if err := s.scanProject(ctx, apiClient, repoURL, reporter); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error scanning project content: %w", err))
+	}
+	return nil
+}
+
+// cloneAndScanRepo clones the repository at repoURL, which may also be the
+// repository of a wiki or snippet, and scans its history.
+func (s *Source) cloneAndScanRepo(ctx context.Context, repoURL string, reporter sources.ChunkReporter) error {
 	var path string
 	var repo *gogit.Repository
 	var err error
@@ -1,13 +1,21 @@
 package gitlab
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
 )
 
@@ -121,7 +129,7 @@ func Test_scanRepos_SetProgressComplete(t *testing.T) {
 			src.jobPool = &errgroup.Group{}
 			src.scanOptions = &git.ScanOptions{}
 
-			_ = src.scanRepos(context.Background(), nil)
+			_ = src.scanRepos(context.Background(), nil, nil)
 			if !tc.wantErr {
 				assert.Equal(t, "", src.GetProgress().EncodedResumeInfo)
 			}
@@ -187,3 +195,194 @@ func Test_normalizeGitlabEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func Test_scanProject_IssuesAndMergeRequests(t *testing.T) {
+	now := time.Now()
+	old := now.AddDate(0, 0, -60)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body any
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/org%2Frepo":
+			body = map[string]any{
+				"id":                  1,
+				"name_with_namespace": "org / repo",
+				"http_url_to_repo":    "https://gitlab.example.com/org/repo.git",
+				"namespace":           map[string]any{"full_path": "org"},
+			}
+		case "/api/v4/projects/1/issues":
+			assert.NotEmpty(t, r.URL.Query().Get("updated_after"))
+			body = []map[string]any{{
+				"id":          5,
+				"iid":         2,
+				"title":       "issue title",
+				"description": "issue description",
+				"web_url":     "https://gitlab.example.com/org/repo/-/issues/2",
+				"created_at":  now,
+			}}
+		case "/api/v4/projects/1/issues/2/notes":
+			body = []map[string]any{
+				{"id": 10, "body": "issue note", "updated_at": now},
+				{"id": 11, "body": "old issue note", "updated_at": old},
+				{"id": 12, "body": "changed the description", "system": true, "updated_at": now},
+			}
+		case "/api/v4/projects/1/merge_requests":
+			body = []map[string]any{{
+				"iid":         3,
+				"title":       "mr title",
+				"description": "mr description",
+				"web_url":     "https://gitlab.example.com/org/repo/-/merge_requests/3",
+			}}
+		case "/api/v4/projects/1/merge_requests/3/discussions":
+			body = []map[string]any{{
+				"id":    "abc",
+				"notes": []map[string]any{{"id": 20, "body": "mr note", "updated_at": now}},
+			}}
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	apiClient, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL))
+	assert.NoError(t, err)
+
+	s := &Source{
+		url:                         "https://gitlab.example.com/",
+		includeIssueComments:        true,
+		includeMergeRequestComments: true,
+		commentsTimeframeDays:       30,
+	}
+
+	chunksChan := make(chan *sources.Chunk, 10)
+	err = s.scanProject(context.Background(), apiClient, "https://gitlab.example.com/org/repo.git", sources.ChanReporter{Ch: chunksChan})
+	assert.NoError(t, err)
+	close(chunksChan)
+
+	got := make(map[string]string)
+	for chunk := range chunksChan {
+		meta := chunk.SourceMetadata.GetGitlab()
+		assert.Equal(t, int64(1), meta.GetProjectId())
+		assert.Equal(t, "org / repo", meta.GetProjectName())
+		assert.Equal(t, "org", meta.GetProjectOwner())
+		got[meta.GetLink()] = string(chunk.Data)
+	}
+	assert.Equal(t, map[string]string{
+		"https://gitlab.example.com/org/repo/-/issues/2":                 "issue title\nissue description",
+		"https://gitlab.example.com/org/repo/-/issues/2#note_10":         "issue note",
+		"https://gitlab.example.com/org/repo/-/merge_requests/3":         "mr title\nmr description",
+		"https://gitlab.example.com/org/repo/-/merge_requests/3#note_20": "mr note",
+	}, got)
+}
+
+func Test_isSnippetURL(t *testing.T) {
+	tests := []struct {
+		repoURL string
+		want    bool
+	}{
+		{repoURL: "https://gitlab.com/org/repo.git", want: false},
+		{repoURL: "https://gitlab.com/org/snippets.git", want: false},
+		{repoURL: "https://gitlab.com/-/snippets/123.git", want: true},
+		{repoURL: "https://gitlab.com/snippets/123.git", want: true},
+		{repoURL: "https://gitlab.com/org/repo/-/snippets/4.git", want: true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isSnippetURL(tt.repoURL), tt.repoURL)
+	}
+}
+
+func Test_scanPipelines(t *testing.T) {
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	f, err := zw.Create("build/config.env")
+	assert.NoError(t, err)
+	_, err = f.Write([]byte("artifact contents"))
+	assert.NoError(t, err)
+	assert.NoError(t, zw.Close())
+
+	expired := time.Now().AddDate(0, 0, -1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body any
+		switch r.URL.EscapedPath() {
+		case "/api/v4/projects/1/pipelines":
+			assert.NotEmpty(t, r.URL.Query().Get("updated_after"))
+			body = []map[string]any{{"id": 7, "sha": "abc123"}}
+		case "/api/v4/projects/1/pipelines/7/jobs":
+			assert.Equal(t, "true", r.URL.Query().Get("include_retried"))
+			body = []map[string]any{
+				{
+					"id":             8,
+					"name":           "build",
+					"web_url":        "https://gitlab.example.com/org/repo/-/jobs/8",
+					"artifacts_file": map[string]any{"filename": "artifacts.zip"},
+				},
+				{
+					"id":                  9,
+					"name":                "erased",
+					"web_url":             "https://gitlab.example.com/org/repo/-/jobs/9",
+					"artifacts_file":      map[string]any{"filename": "artifacts.zip"},
+					"artifacts_expire_at": expired,
+				},
+				{
+					"id":             10,
+					"name":           "huge",
+					"web_url":        "https://gitlab.example.com/org/repo/-/jobs/10",
+					"artifacts_file": map[string]any{"filename": "artifacts.zip", "size": 1025},
+				},
+			}
+		case "/api/v4/projects/1/jobs/8/trace":
+			_, _ = w.Write([]byte("job log"))
+			return
+		case "/api/v4/projects/1/jobs/8/artifacts":
+			_, _ = w.Write(archive.Bytes())
+			return
+		case "/api/v4/projects/1/jobs/9/trace":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case "/api/v4/projects/1/jobs/10/trace":
+			_, _ = w.Write(bytes.Repeat([]byte("x"), 1025))
+			return
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	defer server.Close()
+
+	apiClient, err := gitlab.NewClient("token", gitlab.WithBaseURL(server.URL))
+	assert.NoError(t, err)
+
+	s := &Source{
+		includePipelineLogs:      true,
+		includePipelineArtifacts: true,
+		pipelinesTimeframeDays:   30,
+		maxPipelineDownloadSize:  1024,
+	}
+	project := &gitlab.Project{ID: 1, HTTPURLToRepo: "https://gitlab.example.com/org/repo.git"}
+
+	chunksChan := make(chan *sources.Chunk, 10)
+	err = s.scanPipelines(context.Background(), apiClient, project, sources.ChanReporter{Ch: chunksChan})
+	assert.NoError(t, err)
+	close(chunksChan)
+
+	got := make(map[string]string)
+	for chunk := range chunksChan {
+		meta := chunk.SourceMetadata.GetGitlab()
+		assert.Equal(t, int64(7), meta.GetPipelineId())
+		assert.Equal(t, int64(8), meta.GetJobId())
+		assert.Equal(t, "build", meta.GetJobName())
+		assert.Equal(t, "abc123", meta.GetCommit())
+		got[meta.GetLink()] = string(chunk.Data)
+	}
+	assert.Equal(t, map[string]string{
+		"https://gitlab.example.com/org/repo/-/jobs/8":                  "job log",
+		"https://gitlab.example.com/org/repo/-/jobs/8/artifacts/browse": "artifact contents",
+	}, got)
+}
@@ -0,0 +1,204 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+// defaultMaxPipelineDownloadSize is used when the connection doesn't set a
+// maximum size for job traces and artifacts archives.
+const defaultMaxPipelineDownloadSize = 250 * 1024 * 1024 // 250 MiB
+
+// errDownloadTooLarge is returned by downloadFile for files larger than the
+// maximum size.
+var errDownloadTooLarge = errors.New("download exceeds the maximum size")
+
+// scanPipelines scans the traces and artifacts of the jobs of each pipeline
+// of the project updated within the pipelines timeframe, as configured.
+func (s *Source) scanPipelines(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, reporter sources.ChunkReporter) error {
+	if project.BuildsAccessLevel == gitlab.DisabledAccessControl {
+		return nil
+	}
+
+	opts := &gitlab.ListProjectPipelinesOptions{ListOptions: gitlab.ListOptions{PerPage: contentPaginationLimit}}
+	if s.pipelinesTimeframeDays > 0 {
+		cutoff := time.Now().AddDate(0, 0, -int(s.pipelinesTimeframeDays))
+		opts.UpdatedAfter = &cutoff
+	}
+	pipelines := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PipelineInfo, *gitlab.Response, error) {
+		return apiClient.Pipelines.ListProjectPipelines(project.ID, opts, p, gitlab.WithContext(ctx))
+	})
+	for pipeline, err := range pipelines {
+		if err != nil {
+			return err
+		}
+		pipelineCtx := context.WithValue(ctx, "pipeline_id", pipeline.ID)
+		if err := s.scanPipeline(pipelineCtx, apiClient, project, pipeline, reporter); err != nil {
+			err := fmt.Errorf("error scanning pipeline %d: %w", pipeline.ID, err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanPipeline scans the trace and artifacts of each job of a pipeline,
+// including retried ones. A failure to scan either is reported without
+// stopping the scan of the others.
+func (s *Source) scanPipeline(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, pipeline *gitlab.PipelineInfo, reporter sources.ChunkReporter) error {
+	opts := &gitlab.ListJobsOptions{
+		ListOptions:    gitlab.ListOptions{PerPage: contentPaginationLimit},
+		IncludeRetried: gitlab.Ptr(true),
+	}
+	jobs := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
+		return apiClient.Jobs.ListPipelineJobs(project.ID, pipeline.ID, opts, p, gitlab.WithContext(ctx))
+	})
+	for job, err := range jobs {
+		if err != nil {
+			return err
+		}
+		jobCtx := context.WithValues(ctx, "job_id", job.ID, "job_name", job.Name)
+		if s.includePipelineLogs {
+			if err := s.scanJobTrace(jobCtx, apiClient, project, pipeline, job, reporter); err != nil {
+				err := fmt.Errorf("error scanning trace of job %d: %w", job.ID, err)
+				if err := reporter.ChunkErr(ctx, err); err != nil {
+					return err
+				}
+			}
+		}
+		if s.includePipelineArtifacts && hasArtifactsArchive(job) {
+			if err := s.scanJobArtifacts(jobCtx, apiClient, project, pipeline, job, reporter); err != nil {
+				err := fmt.Errorf("error scanning artifacts of job %d: %w", job.ID, err)
+				if err := reporter.ChunkErr(ctx, err); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hasArtifactsArchive returns whether the job uploaded an artifacts archive
+// that has not expired yet.
+func hasArtifactsArchive(job *gitlab.Job) bool {
+	if job.ArtifactsFile.Filename == "" {
+		return false
+	}
+	return job.ArtifactsExpireAt == nil || job.ArtifactsExpireAt.After(time.Now())
+}
+
+func (s *Source) scanJobTrace(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, pipeline *gitlab.PipelineInfo, job *gitlab.Job, reporter sources.ChunkReporter) error {
+	u := fmt.Sprintf("projects/%d/jobs/%d/trace", project.ID, job.ID)
+	tmp, err := downloadFile(ctx, apiClient, u, s.maxPipelineDownloadSize)
+	if errors.Is(err, gitlab.ErrNotFound) {
+		// Traces are gone once the job is erased.
+		ctx.Logger().V(3).Info("job trace is not available")
+		return nil
+	}
+	if errors.Is(err, errDownloadTooLarge) {
+		ctx.Logger().V(5).Info("Skipping large job trace", "max_pipeline_download_size", s.maxPipelineDownloadSize)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	chunkSkel := s.jobChunk(project, pipeline, job)
+	return handlers.HandleFile(ctx, tmp, chunkSkel, reporter)
+}
+
+func (s *Source) scanJobArtifacts(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, pipeline *gitlab.PipelineInfo, job *gitlab.Job, reporter sources.ChunkReporter) error {
+	if int64(job.ArtifactsFile.Size) > s.maxPipelineDownloadSize {
+		ctx.Logger().V(5).Info("Skipping large artifacts archive", "size", job.ArtifactsFile.Size, "max_pipeline_download_size", s.maxPipelineDownloadSize)
+		return nil
+	}
+
+	u := fmt.Sprintf("projects/%d/jobs/%d/artifacts", project.ID, job.ID)
+	tmp, err := downloadFile(ctx, apiClient, u, s.maxPipelineDownloadSize)
+	if errors.Is(err, errDownloadTooLarge) {
+		ctx.Logger().V(5).Info("Skipping large artifacts archive", "max_pipeline_download_size", s.maxPipelineDownloadSize)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	chunkSkel := s.jobChunk(project, pipeline, job)
+	meta := chunkSkel.SourceMetadata.GetGitlab()
+	meta.File = sanitizer.UTF8(job.ArtifactsFile.Filename)
+	meta.Link = sanitizer.UTF8(job.WebURL + "/artifacts/browse")
+	return handlers.HandleFile(ctx, tmp, chunkSkel, reporter)
+}
+
+// jobChunk returns the chunk skeleton for the trace and artifacts of a job.
+func (s *Source) jobChunk(project *gitlab.Project, pipeline *gitlab.PipelineInfo, job *gitlab.Job) *sources.Chunk {
+	chunk := s.projectChunk(project, job.WebURL, job.CreatedAt)
+	meta := chunk.SourceMetadata.GetGitlab()
+	meta.Commit = sanitizer.UTF8(pipeline.SHA)
+	meta.PipelineId = int64(pipeline.ID)
+	meta.JobId = int64(job.ID)
+	meta.JobName = sanitizer.UTF8(job.Name)
+	if job.User != nil {
+		meta.Email = sanitizer.UTF8(job.User.Email)
+	}
+	return chunk
+}
+
+// downloadFile downloads a job trace or artifacts archive from the API
+// endpoint u into a temporary file, which the caller must remove. The file is
+// downloaded before it is scanned so that slow scans don't hold the
+// connection open. Files larger than maxSize are not kept and
+// errDownloadTooLarge is returned.
+func downloadFile(ctx context.Context, apiClient *gitlab.Client, u string, maxSize int64) (*os.File, error) {
+	req, err := apiClient.NewRequest(http.MethodGet, u, nil, []gitlab.RequestOptionFunc{gitlab.WithContext(ctx)})
+	if err != nil {
+		return nil, err
+	}
+
+	tmp, err := os.CreateTemp("", "trufflehog-gitlab-*")
+	if err != nil {
+		return nil, err
+	}
+	_, err = apiClient.Do(req, &limitedWriter{w: tmp, n: maxSize})
+	if err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, err
+	}
+	return tmp, nil
+}
+
+// limitedWriter writes to w until more than n bytes are written in total,
+// after which it fails with errDownloadTooLarge.
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (l *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.n {
+		return 0, errDownloadTooLarge
+	}
+	n, err := l.w.Write(p)
+	l.n -= int64(n)
+	return n, err
+}
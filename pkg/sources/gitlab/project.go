@@ -0,0 +1,329 @@
+package gitlab
+
+import (
+	"fmt"
+	"iter"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources/git"
+)
+
+const contentPaginationLimit = 100 // Default is 20, max is 100.
+
+// snippetURLPattern matches the repository URLs of project and personal
+// snippets, e.g. https://gitlab.com/-/snippets/123.git.
+var snippetURLPattern = regexp.MustCompile(`/snippets/\d+\.git$`)
+
+// isSnippetURL returns whether repoURL is the repository of a snippet rather
+// than of a project.
+func isSnippetURL(repoURL string) bool {
+	return snippetURLPattern.MatchString(repoURL)
+}
+
+// includesProjectContent returns whether anything besides the repository of
+// a project is configured to be scanned.
+func (s *Source) includesProjectContent() bool {
+	return s.includeIssueComments || s.includeMergeRequestComments || s.includeSnippets || s.includeWikis ||
+		s.includePipelineLogs || s.includePipelineArtifacts
+}
+
+// commentsCutoff returns the time before which issues, merge requests and
+// notes are not scanned, or nil if they are all scanned.
+func (s *Source) commentsCutoff() *time.Time {
+	if s.commentsTimeframeDays == 0 {
+		return nil
+	}
+	cutoff := time.Now().AddDate(0, 0, -int(s.commentsTimeframeDays))
+	return &cutoff
+}
+
+// projectPath returns the path with namespace of the project the repository
+// at repoURL belongs to, e.g. "gitlab-org/gitlab" for
+// https://gitlab.com/gitlab-org/gitlab.git.
+func (s *Source) projectPath(repoURL string) (string, error) {
+	u, err := url.Parse(repoURL)
+	if err != nil {
+		return "", fmt.Errorf("could not parse repo url %q: %w", repoURL, err)
+	}
+	base, err := url.Parse(s.url)
+	if err != nil {
+		return "", err
+	}
+	projectPath := strings.TrimPrefix(u.Path, base.Path)
+	projectPath = strings.TrimSuffix(strings.Trim(projectPath, "/"), ".git")
+	if projectPath == "" {
+		return "", fmt.Errorf("no project path in repo url %q", repoURL)
+	}
+	return projectPath, nil
+}
+
+// scanProject scans the wiki, snippets, issues, merge requests and pipelines
+// of the project the repository at repoURL belongs to, as configured. A
+// failure to scan one of them is reported without stopping the scan of the
+// others.
+func (s *Source) scanProject(ctx context.Context, apiClient *gitlab.Client, repoURL string, reporter sources.ChunkReporter) error {
+	projectPath, err := s.projectPath(repoURL)
+	if err != nil {
+		return err
+	}
+	project, _, err := apiClient.Projects.GetProject(projectPath, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("error getting project %q: %w", projectPath, err)
+	}
+	ctx = context.WithValues(ctx, "project_id", project.ID, "project_name", project.NameWithNamespace)
+
+	for _, content := range []struct {
+		enabled bool
+		name    string
+		scan    func(context.Context, *gitlab.Client, *gitlab.Project, sources.ChunkReporter) error
+	}{
+		{s.includeWikis, "wiki", s.scanWiki},
+		{s.includeSnippets, "snippets", s.scanProjectSnippets},
+		{s.includeIssueComments, "issues", s.scanIssues},
+		{s.includeMergeRequestComments, "merge requests", s.scanMergeRequests},
+		{s.includePipelineLogs || s.includePipelineArtifacts, "pipelines", s.scanPipelines},
+	} {
+		if !content.enabled {
+			continue
+		}
+		if err := content.scan(ctx, apiClient, project, reporter); err != nil {
+			err := fmt.Errorf("error scanning %s: %w", content.name, err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanWiki clones and scans the wiki of the project, if it has any pages.
+func (s *Source) scanWiki(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, reporter sources.ChunkReporter) error {
+	if project.WikiAccessLevel == gitlab.DisabledAccessControl {
+		return nil
+	}
+	// The wiki repository only exists once the first page is created.
+	opts := &gitlab.ListWikisOptions{WithContent: gitlab.Ptr(false)}
+	pages, _, err := apiClient.Wikis.ListWikis(project.ID, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	if len(pages) == 0 {
+		return nil
+	}
+
+	wikiURL := strings.TrimSuffix(project.HTTPURLToRepo, ".git") + ".wiki.git"
+	return s.cloneAndScanRepo(context.WithValue(ctx, "repo", wikiURL), wikiURL, reporter)
+}
+
+// scanProjectSnippets clones and scans the repository of each snippet of the
+// project.
+func (s *Source) scanProjectSnippets(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, reporter sources.ChunkReporter) error {
+	if project.SnippetsAccessLevel == gitlab.DisabledAccessControl {
+		return nil
+	}
+	opts := &gitlab.ListProjectSnippetsOptions{PerPage: contentPaginationLimit}
+	snippets := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Snippet, *gitlab.Response, error) {
+		return apiClient.ProjectSnippets.ListSnippets(project.ID, opts, p, gitlab.WithContext(ctx))
+	})
+	for snippet, err := range snippets {
+		if err != nil {
+			return err
+		}
+		snippetURL := snippet.WebURL + ".git"
+		if err := s.cloneAndScanRepo(context.WithValue(ctx, "repo", snippetURL), snippetURL, reporter); err != nil {
+			err := fmt.Errorf("error scanning snippet %d: %w", snippet.ID, err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// listSnippets reports the repository of each personal snippet of the
+// authenticated user. Project snippets are scanned along with their project.
+func (s *Source) listSnippets(ctx context.Context, apiClient *gitlab.Client, reporter sources.UnitReporter) error {
+	opts := &gitlab.ListSnippetsOptions{PerPage: contentPaginationLimit}
+	snippets := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Snippet, *gitlab.Response, error) {
+		return apiClient.Snippets.ListSnippets(opts, p, gitlab.WithContext(ctx))
+	})
+
+	count := 0
+	for snippet, err := range snippets {
+		if err != nil {
+			err = fmt.Errorf("error during snippet enumeration: %w", err)
+			return reporter.UnitErr(ctx, err)
+		}
+		if snippet.ProjectID != 0 {
+			continue
+		}
+		unit := git.SourceUnit{Kind: git.UnitRepo, ID: snippet.WebURL + ".git"}
+		if err := reporter.UnitOk(ctx, unit); err != nil {
+			return err
+		}
+		count++
+	}
+
+	ctx.Logger().Info("Enumerated GitLab snippets", "count", count)
+	return nil
+}
+
+// scanIssues scans the title and description of each issue of the project
+// updated within the comments timeframe, along with their notes.
+func (s *Source) scanIssues(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, reporter sources.ChunkReporter) error {
+	if project.IssuesAccessLevel == gitlab.DisabledAccessControl {
+		return nil
+	}
+	cutoff := s.commentsCutoff()
+	opts := &gitlab.ListProjectIssuesOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: contentPaginationLimit},
+		UpdatedAfter: cutoff,
+	}
+	issues := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return apiClient.Issues.ListProjectIssues(project.ID, opts, p, gitlab.WithContext(ctx))
+	})
+	for issue, err := range issues {
+		if err != nil {
+			return err
+		}
+		chunk := s.projectChunk(project, issue.WebURL, issue.CreatedAt)
+		chunk.Data = []byte(sanitizer.UTF8(issue.Title + "\n" + issue.Description))
+		if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+			return err
+		}
+
+		notesOpts := &gitlab.ListIssueNotesOptions{ListOptions: gitlab.ListOptions{PerPage: contentPaginationLimit}}
+		notes := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Note, *gitlab.Response, error) {
+			return apiClient.Notes.ListIssueNotes(project.ID, issue.IID, notesOpts, p, gitlab.WithContext(ctx))
+		})
+		if err := s.scanNotes(ctx, project, issue.WebURL, notes, cutoff, reporter); err != nil {
+			err := fmt.Errorf("error scanning notes of issue %d: %w", issue.IID, err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanMergeRequests scans the title and description of each merge request of
+// the project updated within the comments timeframe, along with the notes of
+// their discussions.
+func (s *Source) scanMergeRequests(ctx context.Context, apiClient *gitlab.Client, project *gitlab.Project, reporter sources.ChunkReporter) error {
+	if project.MergeRequestsAccessLevel == gitlab.DisabledAccessControl {
+		return nil
+	}
+	cutoff := s.commentsCutoff()
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		ListOptions:  gitlab.ListOptions{PerPage: contentPaginationLimit},
+		UpdatedAfter: cutoff,
+	}
+	mergeRequests := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return apiClient.MergeRequests.ListProjectMergeRequests(project.ID, opts, p, gitlab.WithContext(ctx))
+	})
+	for mr, err := range mergeRequests {
+		if err != nil {
+			return err
+		}
+		chunk := s.projectChunk(project, mr.WebURL, mr.CreatedAt)
+		chunk.Data = []byte(sanitizer.UTF8(mr.Title + "\n" + mr.Description))
+		if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+			return err
+		}
+
+		discussionsOpts := &gitlab.ListMergeRequestDiscussionsOptions{PerPage: contentPaginationLimit}
+		discussions := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Discussion, *gitlab.Response, error) {
+			return apiClient.Discussions.ListMergeRequestDiscussions(project.ID, mr.IID, discussionsOpts, p, gitlab.WithContext(ctx))
+		})
+		var notes iter.Seq2[*gitlab.Note, error] = func(yield func(*gitlab.Note, error) bool) {
+			for discussion, err := range discussions {
+				if err != nil {
+					yield(nil, err)
+					return
+				}
+				for _, note := range discussion.Notes {
+					if !yield(note, nil) {
+						return
+					}
+				}
+			}
+		}
+		if err := s.scanNotes(ctx, project, mr.WebURL, notes, cutoff, reporter); err != nil {
+			err := fmt.Errorf("error scanning discussions of merge request %d: %w", mr.IID, err)
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// scanNotes scans the body of each note written by a user and updated after
+// the cutoff, if any. Notes generated by GitLab, such as label changes, are
+// skipped. Each note links to its anchor on the page at link.
+func (s *Source) scanNotes(
+	ctx context.Context,
+	project *gitlab.Project,
+	link string,
+	notes iter.Seq2[*gitlab.Note, error],
+	cutoff *time.Time,
+	reporter sources.ChunkReporter,
+) error {
+	for note, err := range notes {
+		if err != nil {
+			return err
+		}
+		if note.System {
+			continue
+		}
+		if cutoff != nil && note.UpdatedAt != nil && note.UpdatedAt.Before(*cutoff) {
+			continue
+		}
+		chunk := s.projectChunk(project, fmt.Sprintf("%s#note_%d", link, note.ID), note.CreatedAt)
+		chunk.Data = []byte(sanitizer.UTF8(note.Body))
+		if err := reporter.ChunkOk(ctx, *chunk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectChunk returns the chunk skeleton for an issue, merge request or note
+// of the project, found at link.
+func (s *Source) projectChunk(project *gitlab.Project, link string, createdAt *time.Time) *sources.Chunk {
+	var timestamp, owner string
+	if createdAt != nil {
+		timestamp = createdAt.String()
+	}
+	if project.Namespace != nil {
+		owner = project.Namespace.FullPath
+	}
+	return &sources.Chunk{
+		SourceName: s.name,
+		SourceID:   s.SourceID(),
+		JobID:      s.JobID(),
+		SourceType: s.Type(),
+		SourceMetadata: &source_metadatapb.MetaData{
+			Data: &source_metadatapb.MetaData_Gitlab{
+				Gitlab: &source_metadatapb.Gitlab{
+					Link:         sanitizer.UTF8(link),
+					Repository:   sanitizer.UTF8(project.HTTPURLToRepo),
+					Timestamp:    sanitizer.UTF8(timestamp),
+					ProjectId:    int64(project.ID),
+					ProjectName:  sanitizer.UTF8(project.NameWithNamespace),
+					ProjectOwner: sanitizer.UTF8(owner),
+				},
+			},
+		},
+		Verify: s.verify,
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
@@ -26,6 +28,8 @@ import (
 
 const (
 	SourceType = sourcespb.SourceType_SOURCE_TYPE_JENKINS
+
+	defaultMaxArtifactSize = 250 * 1024 * 1024 // 250 MiB
 )
 
 type Source struct {
@@ -38,6 +42,11 @@ type Source struct {
 	token    string
 	header   *header
 	client   *http.Client
+
+	includeJobConfigs     bool
+	includeBuildDetails   bool
+	includeBuildArtifacts bool
+	maxArtifactSize       int64
 	sources.Progress
 }
 
@@ -100,6 +109,14 @@ func (s *Source) Init(aCtx context.Context, name string, jobId sources.JobID, so
 
 	s.client = client
 
+	s.includeJobConfigs = conn.GetIncludeJobConfigs()
+	s.includeBuildDetails = conn.GetIncludeBuildDetails()
+	s.includeBuildArtifacts = conn.GetIncludeBuildArtifacts()
+	s.maxArtifactSize = conn.GetMaxArtifactSize()
+	if s.maxArtifactSize <= 0 {
+		s.maxArtifactSize = defaultMaxArtifactSize
+	}
+
 	var unparsedURL string
 	var authMethod string
 	switch cred := conn.GetCredential().(type) {
@@ -349,6 +366,12 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 		projectURL := *s.url
 		projectURL.Path = parsedUrl.Path
 
+		if s.includeJobConfigs {
+			if err := s.chunkJobConfig(ctx, projectURL, project.Name, chunksChan); err != nil {
+				ctx.Logger().Error(err, "error scanning job config")
+			}
+		}
+
 		builds, err := s.GetJenkinsBuilds(ctx, projectURL.Path)
 		if err != nil {
 			ctx.Logger().Error(err, "failed to get builds; skipping job")
@@ -369,6 +392,11 @@ func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ .
 			if err := s.chunkBuild(ctx, build, project.Name, chunksChan); err != nil {
 				ctx.Logger().Error(err, "error scanning build log")
 			}
+			if s.includeBuildDetails || s.includeBuildArtifacts {
+				if err := s.chunkBuildDetails(ctx, build, project.Name, chunksChan); err != nil {
+					ctx.Logger().Error(err, "error scanning build details and artifacts")
+				}
+			}
 		}
 	}
 
@@ -395,42 +423,305 @@ func (s *Source) chunkBuild(
 	ctx = context.WithValues(ctx,
 		"build_log_url", buildLogURL.String())
 
-	req, err := s.NewRequest(http.MethodGet, buildLogURL.String(), nil)
+	resp, err := s.get(buildLogURL)
 	if err != nil {
-		return fmt.Errorf("failed to create HTTP request to %q: %w", buildLogURL.String(), err)
+		return err
 	}
+	defer resp.Body.Close()
+
+	chunkSkel := s.chunkSkel(&source_metadatapb.Jenkins{
+		ProjectName: projectName,
+		BuildNumber: build.Number,
+		Link:        buildLogURL.String(),
+	})
+
+	ctx.Logger().V(4).Info("scanning build log")
+	return handlers.HandleFile(ctx, resp.Body, chunkSkel, sources.ChanReporter{Ch: chunksChan})
+}
 
+// chunkJobConfig sends the config.xml of a job, which holds pipeline scripts
+// and parameter defaults, to the chunksChan.
+func (s *Source) chunkJobConfig(
+	ctx context.Context,
+	projectURL url.URL,
+	projectName string,
+	chunksChan chan *sources.Chunk,
+) error {
+	configURL := projectURL
+	configURL.Path = path.Join(projectURL.Path, "config.xml")
+	ctx = context.WithValues(ctx,
+		"config_url", configURL.String())
+
+	resp, err := s.get(configURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	chunkSkel := s.chunkSkel(&source_metadatapb.Jenkins{
+		ProjectName: projectName,
+		Link:        configURL.String(),
+		File:        "config.xml",
+	})
+
+	ctx.Logger().V(4).Info("scanning job config")
+	return handlers.HandleFile(ctx, resp.Body, chunkSkel, sources.ChanReporter{Ch: chunksChan})
+}
+
+// chunkBuildDetails sends the parameters and injected environment variables of
+// a build, and the artifacts it archived, to the chunksChan.
+func (s *Source) chunkBuildDetails(
+	ctx context.Context,
+	build JenkinsBuild,
+	projectName string,
+	chunksChan chan *sources.Chunk,
+) error {
+	parsedUrl, err := url.Parse(build.Url)
+	if err != nil {
+		return fmt.Errorf("failed to parse build URL %q: %w", build.Url, err)
+	}
+	buildURL := *s.url
+	buildURL.Path = parsedUrl.Path
+
+	detailsURL := buildURL
+	detailsURL.Path = path.Join(buildURL.Path, "api/json")
+	params := url.Values{}
+	params.Set("tree", "timestamp,actions[parameters[name,value]],artifacts[relativePath]")
+	detailsURL.RawQuery = params.Encode()
+
+	resp, err := s.get(detailsURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	details := &JenkinsBuildDetails{}
+	if err := json.NewDecoder(resp.Body).Decode(details); err != nil {
+		return fmt.Errorf("failed to decode build details from %q: %w", detailsURL.String(), err)
+	}
+	timestamp := time.UnixMilli(details.Timestamp).UTC().String()
+
+	if s.includeBuildDetails {
+		if err := s.chunkBuildParameters(ctx, buildURL, details, build.Number, projectName, timestamp, chunksChan); err != nil {
+			return err
+		}
+		if err := s.chunkBuildEnvironment(ctx, buildURL, build.Number, projectName, timestamp, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning build environment")
+		}
+	}
+
+	if !s.includeBuildArtifacts {
+		return nil
+	}
+	for _, artifact := range details.Artifacts {
+		artifactURL := buildURL
+		artifactURL.Path = path.Join(buildURL.Path, "artifact", artifact.RelativePath)
+		artifactCtx := context.WithValues(ctx,
+			"artifact_url", artifactURL.String())
+
+		chunkSkel := s.chunkSkel(&source_metadatapb.Jenkins{
+			ProjectName: projectName,
+			BuildNumber: build.Number,
+			Link:        artifactURL.String(),
+			Timestamp:   timestamp,
+			File:        artifact.RelativePath,
+		})
+		if err := s.chunkArtifact(artifactCtx, artifactURL, chunkSkel, chunksChan); err != nil {
+			ctx.Logger().Error(err, "error scanning build artifact")
+		}
+	}
+	return nil
+}
+
+// chunkBuildParameters sends the parameters a build was started with to the
+// chunksChan.
+func (s *Source) chunkBuildParameters(
+	ctx context.Context,
+	buildURL url.URL,
+	details *JenkinsBuildDetails,
+	buildNumber int64,
+	projectName string,
+	timestamp string,
+	chunksChan chan *sources.Chunk,
+) error {
+	var parameters strings.Builder
+	for _, action := range details.Actions {
+		for _, param := range action.Parameters {
+			if param.Value == nil {
+				// Password parameters are never returned.
+				continue
+			}
+			fmt.Fprintf(&parameters, "%s=%v\n", param.Name, param.Value)
+		}
+	}
+	if parameters.Len() == 0 {
+		return nil
+	}
+
+	parametersURL := buildURL
+	parametersURL.Path = path.Join(buildURL.Path, "parameters") + "/"
+	chunk := s.chunkSkel(&source_metadatapb.Jenkins{
+		ProjectName: projectName,
+		BuildNumber: buildNumber,
+		Link:        parametersURL.String(),
+		Timestamp:   timestamp,
+	})
+	chunk.Data = []byte(parameters.String())
+	return common.CancellableWrite(ctx, chunksChan, chunk)
+}
+
+// chunkBuildEnvironment sends the environment variables injected into a build
+// by the EnvInject plugin to the chunksChan. Builds on instances without the
+// plugin are skipped.
+func (s *Source) chunkBuildEnvironment(
+	ctx context.Context,
+	buildURL url.URL,
+	buildNumber int64,
+	projectName string,
+	timestamp string,
+	chunksChan chan *sources.Chunk,
+) error {
+	envURL := buildURL
+	envURL.Path = path.Join(buildURL.Path, "injectedEnvVars", "api/json")
+
+	req, err := s.NewRequest(http.MethodGet, envURL.String(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request to %q: %w", envURL.String(), err)
+	}
 	resp, err := s.client.Do(req)
 	if err != nil {
-		return fmt.Errorf("could not retrieve build log from %q: %w", buildLogURL.String(), err)
+		return fmt.Errorf("could not retrieve %q: %w", envURL.String(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		ctx.Logger().V(5).Info("no injected environment variables found", "env_url", envURL.String())
+		return nil
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("got unexpected HTTP status code %v when trying to retrieve %q", resp.StatusCode, envURL.String())
+	}
+
+	env := &JenkinsInjectedEnvVars{}
+	if err := json.NewDecoder(resp.Body).Decode(env); err != nil {
+		return fmt.Errorf("failed to decode injected environment variables from %q: %w", envURL.String(), err)
+	}
+	if len(env.EnvMap) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(env.EnvMap))
+	for name := range env.EnvMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var environment strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&environment, "%s=%s\n", name, env.EnvMap[name])
+	}
+
+	linkURL := buildURL
+	linkURL.Path = path.Join(buildURL.Path, "injectedEnvVars") + "/"
+	chunk := s.chunkSkel(&source_metadatapb.Jenkins{
+		ProjectName: projectName,
+		BuildNumber: buildNumber,
+		Link:        linkURL.String(),
+		Timestamp:   timestamp,
+	})
+	chunk.Data = []byte(environment.String())
+	return common.CancellableWrite(ctx, chunksChan, chunk)
+}
+
+// chunkArtifact sends the build artifact found at u to the chunksChan.
+// Artifacts larger than the configured maximum size are skipped.
+func (s *Source) chunkArtifact(ctx context.Context, u url.URL, chunkSkel *sources.Chunk, chunksChan chan *sources.Chunk) error {
+	resp, err := s.get(u)
+	if err != nil {
+		return err
 	}
 	defer resp.Body.Close()
 
+	if resp.ContentLength > s.maxArtifactSize {
+		ctx.Logger().V(5).Info("Skipping large build artifact", "size", resp.ContentLength, "max_artifact_size", s.maxArtifactSize)
+		return nil
+	}
+
+	body := io.Reader(resp.Body)
+	if resp.ContentLength < 0 {
+		// Without a Content-Length the size is only known once the artifact
+		// has been read, so it is downloaded before it is scanned.
+		tmp, size, err := downloadArtifact(resp.Body, s.maxArtifactSize)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+		if size > s.maxArtifactSize {
+			ctx.Logger().V(5).Info("Skipping large build artifact without a Content-Length", "max_artifact_size", s.maxArtifactSize)
+			return nil
+		}
+		body = tmp
+	}
+
+	ctx.Logger().V(4).Info("scanning build artifact")
+	return handlers.HandleFile(ctx, body, chunkSkel, sources.ChanReporter{Ch: chunksChan})
+}
+
+// downloadArtifact copies up to one byte more than maxSize of body into a
+// temporary file, which the caller must remove, and returns it rewound along
+// with the number of bytes copied. A size above maxSize means the artifact is
+// larger than maxSize.
+func downloadArtifact(body io.Reader, maxSize int64) (*os.File, int64, error) {
+	tmp, err := os.CreateTemp("", "trufflehog-jenkins-*")
+	if err != nil {
+		return nil, 0, err
+	}
+	size, err := io.Copy(tmp, io.LimitReader(body, maxSize+1))
+	if err == nil {
+		_, err = tmp.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return nil, 0, fmt.Errorf("error downloading build artifact: %w", err)
+	}
+	return tmp, size, nil
+}
+
+// get requests u and returns the response if it was successful.
+func (s *Source) get(u url.URL) (*http.Response, error) {
+	req, err := s.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request to %q: %w", u.String(), err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve %q: %w", u.String(), err)
+	}
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("got unexpected HTTP status code %v when trying to retrieve build log from %q",
-			resp.StatusCode,
-			buildLogURL.String())
+		resp.Body.Close()
+		return nil, fmt.Errorf("got unexpected HTTP status code %v when trying to retrieve %q", resp.StatusCode, u.String())
 	}
+	return resp, nil
+}
 
-	chunkSkel := &sources.Chunk{
+// chunkSkel returns the chunk skeleton for Jenkins content with the given
+// metadata.
+func (s *Source) chunkSkel(meta *source_metadatapb.Jenkins) *sources.Chunk {
+	return &sources.Chunk{
 		SourceName: s.name,
 		SourceID:   s.SourceID(),
 		SourceType: s.Type(),
 		JobID:      s.JobID(),
 		SourceMetadata: &source_metadatapb.MetaData{
 			Data: &source_metadatapb.MetaData_Jenkins{
-				Jenkins: &source_metadatapb.Jenkins{
-					ProjectName: projectName,
-					BuildNumber: build.Number,
-					Link:        buildLogURL.String(),
-				},
+				Jenkins: meta,
 			},
 		},
 		Verify: s.verify,
 	}
-
-	ctx.Logger().V(4).Info("scanning build log")
-	return handlers.HandleFile(ctx, resp.Body, chunkSkel, sources.ChanReporter{Ch: chunksChan})
 }
 
 type JenkinsJobResponse struct {
@@ -449,3 +740,24 @@ type JenkinsBuild struct {
 	Number int64  `json:"number"`
 	Url    string `json:"url"`
 }
+
+// JenkinsBuildDetails is the subset of a build's JSON API response holding
+// its parameters and archived artifacts.
+type JenkinsBuildDetails struct {
+	Timestamp int64 `json:"timestamp"`
+	Actions   []struct {
+		Parameters []struct {
+			Name  string `json:"name"`
+			Value any    `json:"value"`
+		} `json:"parameters"`
+	} `json:"actions"`
+	Artifacts []struct {
+		RelativePath string `json:"relativePath"`
+	} `json:"artifacts"`
+}
+
+// JenkinsInjectedEnvVars is the response of the EnvInject plugin's
+// injectedEnvVars API for a build.
+type JenkinsInjectedEnvVars struct {
+	EnvMap map[string]string `json:"envMap"`
+}
@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -297,3 +298,136 @@ func TestJenkinsChunkBuildDirect(t *testing.T) {
 	expectedLink := server.URL + "/job/test-project/123/consoleText"
 	assert.Equal(t, expectedLink, jenkinsMetadata.Link)
 }
+
+// TestJenkinsJobConfigParametersAndArtifacts verifies that job configs, build
+// parameters, injected environment variables and archived artifacts are
+// scanned along with the build log when enabled, each with its own metadata.
+func TestJenkinsJobConfigParametersAndArtifacts(t *testing.T) {
+	server := createMockJenkinsServer("test-job", 7, "build log")
+	defer server.Close()
+
+	mux := server.Config.Handler.(*http.ServeMux)
+	mux.HandleFunc("/job/test-job/config.xml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, "<flow-definition><script>sh 'deploy --token abc123'</script></flow-definition>")
+	})
+	mux.HandleFunc("/job/test-job/7/api/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"timestamp":1700000000000,"actions":[{},{"parameters":[`+
+			`{"name":"API_KEY","value":"abc123"},{"name":"DRY_RUN","value":false},{"name":"PASSWORD"}]}],`+
+			`"artifacts":[{"relativePath":"dist/app.env"},{"relativePath":"dist/huge.bin"},`+
+			`{"relativePath":"dist/streamed.env"},{"relativePath":"dist/streamed.bin"}]}`)
+	})
+	mux.HandleFunc("/job/test-job/7/injectedEnvVars/api/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"envMap":{"DEPLOY_TOKEN":"abc123","BUILD_ID":"7"}}`)
+	})
+	mux.HandleFunc("/job/test-job/7/artifact/dist/app.env", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "SECRET=abc123")
+	})
+	mux.HandleFunc("/job/test-job/7/artifact/dist/huge.bin", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("x", 1025))
+	})
+	// Flushing before writing the body sends the artifact without a
+	// Content-Length.
+	mux.HandleFunc("/job/test-job/7/artifact/dist/streamed.env", func(w http.ResponseWriter, r *http.Request) {
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, "STREAMED=abc123")
+	})
+	mux.HandleFunc("/job/test-job/7/artifact/dist/streamed.bin", func(w http.ResponseWriter, r *http.Request) {
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, strings.Repeat("x", 1025))
+	})
+
+	s := new(Source)
+	conn, err := anypb.New(&sourcespb.Jenkins{
+		Endpoint:              server.URL,
+		Credential:            &sourcespb.Jenkins_Unauthenticated{},
+		IncludeJobConfigs:     true,
+		IncludeBuildDetails:   true,
+		IncludeBuildArtifacts: true,
+		MaxArtifactSize:       1024,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = s.Init(ctx, "test-jenkins", 0, 1, false, conn, runtime.NumCPU())
+	require.NoError(t, err)
+
+	chunksChan := make(chan *sources.Chunk, 10)
+	done := make(chan error, 1)
+	go func() {
+		defer close(chunksChan)
+		done <- s.Chunks(ctx, chunksChan)
+	}()
+
+	type chunkMeta struct {
+		Data, Link, File string
+		BuildNumber      int64
+	}
+	var got []chunkMeta
+	for chunk := range chunksChan {
+		meta := chunk.SourceMetadata.GetJenkins()
+		require.NotNil(t, meta, "Missing Jenkins metadata")
+		assert.Equal(t, "test-job", meta.ProjectName)
+		got = append(got, chunkMeta{string(chunk.Data), meta.Link, meta.File, meta.BuildNumber})
+	}
+	require.NoError(t, <-done)
+
+	want := []chunkMeta{
+		{"<flow-definition><script>sh 'deploy --token abc123'</script></flow-definition>", server.URL + "/job/test-job/config.xml", "config.xml", 0},
+		{"build log", server.URL + "/job/test-job/7/consoleText", "", 7},
+		{"API_KEY=abc123\nDRY_RUN=false\n", server.URL + "/job/test-job/7/parameters/", "", 7},
+		{"BUILD_ID=7\nDEPLOY_TOKEN=abc123\n", server.URL + "/job/test-job/7/injectedEnvVars/", "", 7},
+		{"SECRET=abc123", server.URL + "/job/test-job/7/artifact/dist/app.env", "dist/app.env", 7},
+		{"STREAMED=abc123", server.URL + "/job/test-job/7/artifact/dist/streamed.env", "dist/streamed.env", 7},
+	}
+	assert.Equal(t, want, got)
+}
+
+// TestJenkinsBuildDetailsOptIn verifies that only build logs are scanned by
+// default.
+func TestJenkinsBuildDetailsOptIn(t *testing.T) {
+	server := createMockJenkinsServer("test-job", 7, "build log")
+	defer server.Close()
+
+	var extraRequests atomic.Int32
+	mux := server.Config.Handler.(*http.ServeMux)
+	for _, p := range []string{"/job/test-job/config.xml", "/job/test-job/7/api/json", "/job/test-job/7/injectedEnvVars/api/json"} {
+		mux.HandleFunc(p, func(w http.ResponseWriter, r *http.Request) {
+			extraRequests.Add(1)
+			http.NotFound(w, r)
+		})
+	}
+
+	s := new(Source)
+	conn, err := anypb.New(&sourcespb.Jenkins{
+		Endpoint:   server.URL,
+		Credential: &sourcespb.Jenkins_Unauthenticated{},
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = s.Init(ctx, "test-jenkins", 0, 1, false, conn, runtime.NumCPU())
+	require.NoError(t, err)
+
+	chunksChan := make(chan *sources.Chunk, 10)
+	done := make(chan error, 1)
+	go func() {
+		defer close(chunksChan)
+		done <- s.Chunks(ctx, chunksChan)
+	}()
+
+	var links []string
+	for chunk := range chunksChan {
+		links = append(links, chunk.SourceMetadata.GetJenkins().GetLink())
+	}
+	require.NoError(t, <-done)
+
+	assert.Equal(t, []string{server.URL + "/job/test-job/7/consoleText"}, links)
+	assert.Zero(t, extraRequests.Load())
+}
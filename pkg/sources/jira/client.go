@@ -0,0 +1,287 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	// apiPath is the path of the Jira REST API relative to the configured
+	// endpoint. Version 2 returns rich text fields as wiki markup rather than
+	// ADF documents. Its endpoints are available on both Cloud and Data
+	// Center, except for issue search: Cloud only supports search/jql.
+	apiPath = "rest/api/2"
+
+	// deploymentCloud is the deploymentType serverInfo reports for Jira Cloud.
+	deploymentCloud = "Cloud"
+
+	paginationLimit = 50
+
+	// searchFields are the issue fields requested when searching.
+	searchFields = "summary,description,environment,reporter,created,updated,attachment"
+)
+
+type project struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type user struct {
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+}
+
+type comment struct {
+	ID      string `json:"id"`
+	Author  user   `json:"author"`
+	Body    string `json:"body"`
+	Created string `json:"created"`
+	Updated string `json:"updated"`
+}
+
+type attachment struct {
+	ID       string `json:"id"`
+	Filename string `json:"filename"`
+	Author   user   `json:"author"`
+	Created  string `json:"created"`
+	Size     int64  `json:"size"`
+	Content  string `json:"content"`
+}
+
+type issue struct {
+	ID     string `json:"id"`
+	Key    string `json:"key"`
+	Fields struct {
+		Summary     string       `json:"summary"`
+		Description string       `json:"description"`
+		Environment string       `json:"environment"`
+		Reporter    user         `json:"reporter"`
+		Created     string       `json:"created"`
+		Updated     string       `json:"updated"`
+		Attachment  []attachment `json:"attachment"`
+	} `json:"fields"`
+}
+
+type searchPage struct {
+	StartAt    int     `json:"startAt"`
+	MaxResults int     `json:"maxResults"`
+	Total      int     `json:"total"`
+	Issues     []issue `json:"issues"`
+}
+
+// cloudSearchPage is a page of the Cloud search/jql endpoint, which pages
+// with a token and doesn't report the total number of issues.
+type cloudSearchPage struct {
+	Issues        []issue `json:"issues"`
+	NextPageToken string  `json:"nextPageToken"`
+	IsLast        bool    `json:"isLast"`
+}
+
+type serverInfo struct {
+	DeploymentType string `json:"deploymentType"`
+}
+
+type commentPage struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Comments   []comment `json:"comments"`
+}
+
+// client is a minimal Jira REST client.
+type client struct {
+	httpClient *http.Client
+	// baseURL is the configured endpoint, e.g. https://example.atlassian.net.
+	baseURL   *url.URL
+	authorize func(*http.Request)
+
+	mu sync.Mutex
+	// cloud is set once the deployment type has been fetched.
+	cloud *bool
+}
+
+// listProjects returns every project visible to the credentials.
+func (c *client) listProjects(ctx context.Context) ([]project, error) {
+	var projects []project
+	err := c.get(ctx, c.apiEndpoint("project", nil), &projects)
+	return projects, err
+}
+
+// isCloud reports whether the instance is Jira Cloud. The deployment type is
+// only fetched once.
+func (c *client) isCloud(ctx context.Context) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cloud != nil {
+		return *c.cloud, nil
+	}
+	var info serverInfo
+	if err := c.get(ctx, c.apiEndpoint("serverInfo", nil), &info); err != nil {
+		return false, fmt.Errorf("error getting Jira server info: %w", err)
+	}
+	cloud := info.DeploymentType == deploymentCloud
+	c.cloud = &cloud
+	return cloud, nil
+}
+
+// searchIssues calls visit for every issue matching the JQL query, in the
+// order given by the query.
+func (c *client) searchIssues(ctx context.Context, jql string, visit func(issue) error) error {
+	cloud, err := c.isCloud(ctx)
+	if err != nil {
+		return err
+	}
+	if cloud {
+		return c.searchCloudIssues(ctx, jql, visit)
+	}
+
+	for startAt := 0; ; {
+		query := url.Values{
+			"jql":        {jql},
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(paginationLimit)},
+			"fields":     {searchFields},
+		}
+		var page searchPage
+		if err := c.get(ctx, c.apiEndpoint("search", query), &page); err != nil {
+			return err
+		}
+		for _, is := range page.Issues {
+			if err := visit(is); err != nil {
+				return err
+			}
+		}
+		startAt += len(page.Issues)
+		if len(page.Issues) == 0 || startAt >= page.Total {
+			return nil
+		}
+	}
+}
+
+// searchCloudIssues is searchIssues for Jira Cloud, which removed the search
+// endpoint in favor of search/jql.
+func (c *client) searchCloudIssues(ctx context.Context, jql string, visit func(issue) error) error {
+	for pageToken := ""; ; {
+		query := url.Values{
+			"jql":        {jql},
+			"maxResults": {strconv.Itoa(paginationLimit)},
+			"fields":     {searchFields},
+		}
+		if pageToken != "" {
+			query.Set("nextPageToken", pageToken)
+		}
+		var page cloudSearchPage
+		if err := c.get(ctx, c.apiEndpoint("search/jql", query), &page); err != nil {
+			return err
+		}
+		for _, is := range page.Issues {
+			if err := visit(is); err != nil {
+				return err
+			}
+		}
+		if page.IsLast || page.NextPageToken == "" {
+			return nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// listComments calls visit for every comment on an issue.
+func (c *client) listComments(ctx context.Context, issueKey string, visit func(comment) error) error {
+	for startAt := 0; ; {
+		query := url.Values{
+			"startAt":    {strconv.Itoa(startAt)},
+			"maxResults": {strconv.Itoa(paginationLimit)},
+		}
+		var page commentPage
+		if err := c.get(ctx, c.apiEndpoint("issue/"+url.PathEscape(issueKey)+"/comment", query), &page); err != nil {
+			return err
+		}
+		for _, cm := range page.Comments {
+			if err := visit(cm); err != nil {
+				return err
+			}
+		}
+		startAt += len(page.Comments)
+		if len(page.Comments) == 0 || startAt >= page.Total {
+			return nil
+		}
+	}
+}
+
+// download opens an attachment's content URL. The caller must close the body.
+func (c *client) download(ctx context.Context, contentURL string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, contentURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code from Jira: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// checkAuth makes a cheap authenticated request to validate the credentials.
+func (c *client) checkAuth(ctx context.Context) error {
+	var out json.RawMessage
+	return c.get(ctx, c.apiEndpoint("myself", nil), &out)
+}
+
+// issueLink returns the web link of an issue, optionally focused on a comment.
+func (c *client) issueLink(issueKey, commentID string) string {
+	u := c.baseURL.JoinPath("browse", issueKey)
+	if commentID != "" {
+		u.RawQuery = url.Values{"focusedCommentId": {commentID}}.Encode()
+	}
+	return u.String()
+}
+
+func (c *client) apiEndpoint(path string, query url.Values) string {
+	u := c.baseURL.JoinPath(apiPath, path)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (c *client) get(ctx context.Context, reqURL string, target any) error {
+	resp, err := c.do(ctx, reqURL)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from Jira API: %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Jira API response: %w", err)
+	}
+	return nil
+}
+
+func (c *client) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Jira request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	c.authorize(req)
+
+	ctx.Logger().V(4).Info("executing query", "query_url", reqURL)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Jira: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,417 @@
+package jira
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_JIRA
+
+// UnitProject is the SourceUnitKind of a Jira project.
+const UnitProject sources.SourceUnitKind = "project"
+
+// Locations reported in the Jira source metadata.
+const (
+	locationIssue      = "issue"
+	locationComment    = "comment"
+	locationAttachment = "attachment"
+)
+
+type Source struct {
+	name   string
+	id     sources.SourceID
+	jobID  sources.JobID
+	verify bool
+
+	authMethod     string
+	projects       []string
+	ignoreProjects map[string]struct{}
+	jql            string
+	since          time.Time
+
+	client *client
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.id
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Jira source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.id = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	var conn sourcespb.JIRA
+	err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{})
+	if err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	// Issues are scanned in key order to support resumption, so the query
+	// must not impose its own ordering.
+	s.jql = strings.TrimSpace(conn.GetJql())
+	if strings.Contains(strings.ToUpper(s.jql), "ORDER BY") {
+		return fmt.Errorf("JQL query for Jira source %q must not contain an ORDER BY clause", name)
+	}
+	// A relative since is resolved here rather than by the caller, so the
+	// connection stays the same across runs and a checkpoint can resume.
+	if s.since, err = parseSince(conn.GetSince(), time.Now()); err != nil {
+		return fmt.Errorf("invalid since value for Jira source %q: %w", name, err)
+	}
+
+	s.projects = conn.GetProjects()
+	s.ignoreProjects = make(map[string]struct{}, len(conn.GetIgnoreProjects()))
+	for _, key := range conn.GetIgnoreProjects() {
+		s.ignoreProjects[strings.ToUpper(key)] = struct{}{}
+	}
+
+	var authorize func(*http.Request)
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.JIRA_BasicAuth:
+		s.authMethod = "BASIC_AUTH"
+		user, password := cred.BasicAuth.GetUsername(), cred.BasicAuth.GetPassword()
+		if password == "" {
+			return fmt.Errorf("Jira source basic auth credential requires 'password' to be specified")
+		}
+		log.RedactGlobally(password)
+		authorize = func(req *http.Request) { req.SetBasicAuth(user, password) }
+	case *sourcespb.JIRA_Token:
+		s.authMethod = "TOKEN"
+		if authorize, err = bearerAuth(cred.Token); err != nil {
+			return fmt.Errorf("invalid token credential for Jira source %q: %w", name, err)
+		}
+	case *sourcespb.JIRA_Oauth:
+		s.authMethod = "OAUTH"
+		if authorize, err = bearerAuth(cred.Oauth.GetAccessToken()); err != nil {
+			return fmt.Errorf("invalid oauth credential for Jira source %q: %w", name, err)
+		}
+	case *sourcespb.JIRA_Unauthenticated:
+		s.authMethod = "UNAUTHENTICATED"
+		authorize = func(*http.Request) {}
+	default:
+		return fmt.Errorf("unknown or unspecified authentication method provided for Jira source %q (unauthenticated scans must be explicitly configured)", name)
+	}
+
+	endpoint := strings.TrimSuffix(conn.GetEndpoint(), "/")
+	baseURL, err := url.Parse(endpoint)
+	if err != nil || endpoint == "" {
+		return fmt.Errorf("invalid endpoint URL given for Jira source: %q", conn.GetEndpoint())
+	}
+	if baseURL.Scheme != "https" && baseURL.Scheme != "http" {
+		return fmt.Errorf("invalid endpoint URL given for Jira source: %q: scheme must be http or https", conn.GetEndpoint())
+	}
+
+	var opts []func(*roundtripper.RoundTripper)
+	if conn.GetInsecureSkipVerifyTls() {
+		opts = append(opts, roundtripper.WithInsecureTLS())
+	}
+	const retryDelay = time.Second * 30
+	opts = append(opts,
+		roundtripper.WithLogger(ctx.Logger()),
+		roundtripper.WithLogging(),
+		roundtripper.WithRetryable(
+			roundtripper.WithShouldRetry5XXDuration(retryDelay),
+		),
+	)
+
+	s.client = &client{
+		httpClient: &http.Client{Transport: roundtripper.NewRoundTripper(nil, opts...)},
+		baseURL:    baseURL,
+		authorize:  authorize,
+	}
+
+	ctx.Logger().V(1).Info("initialized Jira source",
+		"auth_method", s.authMethod,
+		"endpoint", baseURL.String(),
+		"jql", s.jql,
+		"since", s.since)
+	return nil
+}
+
+func bearerAuth(token string) (func(*http.Request), error) {
+	if token == "" {
+		return nil, fmt.Errorf("no token provided")
+	}
+	log.RedactGlobally(token)
+	return func(req *http.Request) { req.Header.Set("Authorization", "Bearer "+token) }, nil
+}
+
+// Validate checks that the configured credentials can reach the Jira API.
+func (s *Source) Validate(ctx context.Context) []error {
+	if err := s.client.checkAuth(ctx); err != nil {
+		return []error{fmt.Errorf("jira authentication failed using method %v: %w", s.authMethod, err)}
+	}
+	return nil
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var projects []string
+	visitor := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			id, _ := unit.SourceUnitID()
+			projects = append(projects, id)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, visitor); err != nil {
+		return err
+	}
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	for i, key := range projects {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		s.SetProgressComplete(i, len(projects), fmt.Sprintf("Project: %s", key), s.EncodedResumeInfo)
+		unit := sources.CommonSourceUnit{Kind: UnitProject, ID: key}
+		if err := s.ChunkUnit(ctx, unit, reporter); err != nil {
+			ctx.Logger().Error(err, "error scanning project", "project", key)
+		}
+	}
+
+	s.SetProgressComplete(len(projects), len(projects), fmt.Sprintf("Completed scanning source %s", s.name), "")
+	return nil
+}
+
+// Enumerate reports the Jira projects to be scanned to the reporter. If none
+// are configured, it lists every project the credentials can see, skipping
+// ignored ones.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	keys := s.projects
+	if len(keys) == 0 {
+		projects, err := s.client.listProjects(ctx)
+		if err != nil {
+			return reporter.UnitErr(ctx, fmt.Errorf("error listing projects: %w", err))
+		}
+		for _, p := range projects {
+			keys = append(keys, p.Key)
+		}
+	}
+
+	for _, key := range keys {
+		if _, ok := s.ignoreProjects[strings.ToUpper(key)]; ok {
+			ctx.Logger().V(3).Info("skipping project", "project", key, "reason", "ignored in config")
+			continue
+		}
+		if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitProject, ID: key}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChunkUnit reports chunks for every matching issue in a project, including
+// its comments and attachments.
+//
+// Issues are scanned in key order and the key of the last completed issue is
+// saved as the unit's resume info, so an interrupted scan continues after it.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	projectKey, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "project", projectKey)
+
+	resumeAfter := s.GetEncodedResumeInfoFor(projectKey)
+	if resumeAfter != "" {
+		ctx.Logger().V(2).Info("resuming project scan", "after_issue", resumeAfter)
+	}
+
+	jql := s.buildJQL(projectKey, resumeAfter, time.Now())
+	err := s.client.searchIssues(ctx, jql, func(is issue) error {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.chunkIssue(ctx, is, reporter); err != nil {
+			return err
+		}
+		// Don't mark an issue as done if the scan was cancelled part way through.
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		s.SetEncodedResumeInfoFor(projectKey, is.Key)
+		return nil
+	})
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error searching issues in project %q: %w", projectKey, err))
+	}
+
+	s.ClearEncodedResumeInfoFor(projectKey)
+	return nil
+}
+
+// buildJQL returns the query for issues in a project, combining the
+// configured JQL and time window, and skipping issues up to and including
+// resumeAfter.
+func (s *Source) buildJQL(projectKey, resumeAfter string, now time.Time) string {
+	clauses := []string{"project = " + strconv.Quote(projectKey)}
+	if s.jql != "" {
+		clauses = append(clauses, "("+s.jql+")")
+	}
+	if !s.since.IsZero() {
+		// A relative offset avoids depending on the timezone Jira uses to
+		// interpret absolute dates for the authenticated user.
+		minutes := int64(math.Ceil(now.Sub(s.since).Minutes()))
+		clauses = append(clauses, fmt.Sprintf(`updated >= "-%dm"`, max(minutes, 0)))
+	}
+	if resumeAfter != "" {
+		clauses = append(clauses, "key > "+strconv.Quote(resumeAfter))
+	}
+	return strings.Join(clauses, " AND ") + " ORDER BY key ASC"
+}
+
+// chunkIssue reports the issue's text fields, each of its comments and its
+// attachments. Errors listing comments or downloading attachments are
+// reported to the reporter and do not stop the scan.
+func (s *Source) chunkIssue(ctx context.Context, is issue, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "issue", is.Key)
+	ctx.Logger().V(3).Info("scanning issue")
+
+	fields := is.Fields
+	text := strings.Join(nonEmpty(fields.Summary, fields.Description, fields.Environment), "\n")
+	if text != "" {
+		meta := s.metadata(is.Key, fields.Reporter, s.client.issueLink(is.Key, ""), locationIssue, fields.Created)
+		if err := reporter.ChunkOk(ctx, s.chunk(meta, []byte(text))); err != nil {
+			return err
+		}
+	}
+
+	if common.IsDone(ctx) {
+		return ctx.Err()
+	}
+	err := s.client.listComments(ctx, is.Key, func(c comment) error {
+		if c.Body == "" {
+			return nil
+		}
+		meta := s.metadata(is.Key, c.Author, s.client.issueLink(is.Key, c.ID), locationComment, c.Created)
+		return reporter.ChunkOk(ctx, s.chunk(meta, []byte(c.Body)))
+	})
+	if err != nil {
+		if err := reporter.ChunkErr(ctx, fmt.Errorf("error listing comments of issue %q: %w", is.Key, err)); err != nil {
+			return err
+		}
+	}
+
+	for _, a := range fields.Attachment {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.chunkAttachment(ctx, is, a, reporter); err != nil {
+			if err := reporter.ChunkErr(ctx, err); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Source) chunkAttachment(ctx context.Context, is issue, a attachment, reporter sources.ChunkReporter) error {
+	ctx = context.WithValue(ctx, "attachment", a.Filename)
+	if a.Content == "" {
+		return fmt.Errorf("attachment %q of issue %q has no content URL", a.Filename, is.Key)
+	}
+
+	body, err := s.client.download(ctx, a.Content)
+	if err != nil {
+		return fmt.Errorf("error downloading attachment %q of issue %q: %w", a.Filename, is.Key, err)
+	}
+	defer body.Close()
+
+	// The Jira metadata has no file field, so the link points at the
+	// attachment itself to identify it.
+	chunkSkel := s.chunk(s.metadata(is.Key, a.Author, a.Content, locationAttachment, a.Created), nil)
+	ctx.Logger().V(4).Info("scanning attachment")
+	return handlers.HandleFile(ctx, body, &chunkSkel, reporter)
+}
+
+func (s *Source) chunk(meta *source_metadatapb.MetaData, data []byte) sources.Chunk {
+	return sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: meta,
+		Data:           data,
+		Verify:         s.verify,
+	}
+}
+
+func (s *Source) metadata(issueKey string, author user, link, location, timestamp string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Jira{
+			Jira: &source_metadatapb.Jira{
+				Issue:     issueKey,
+				Author:    sanitizer.UTF8(author.DisplayName),
+				Email:     sanitizer.UTF8(author.EmailAddress),
+				Link:      link,
+				Location:  location,
+				Timestamp: timestamp,
+			},
+		},
+	}
+}
+
+func nonEmpty(values ...string) []string {
+	out := values[:0]
+	for _, v := range values {
+		if v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// parseSince parses a point in time given as a date, an RFC 3339 timestamp,
+// or a duration before now. An empty value returns the zero time.
+func parseSince(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, value); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d >= 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date, timestamp or duration", value)
+}
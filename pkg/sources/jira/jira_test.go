@@ -0,0 +1,354 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+// testServer is a minimal Jira instance with projects OPS and HR. Project OPS
+// has issues OPS-1 and OPS-2; OPS-1 has two pages of comments and an
+// attachment. Like Jira Cloud, a Cloud deployment only supports search/jql.
+type testServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	queries []string
+}
+
+const deploymentDataCenter = "DataCenter"
+
+func newTestServer(t *testing.T, deploymentType string) *testServer {
+	t.Helper()
+	ts := &testServer{}
+
+	issue := func(key, description string, attachments ...any) map[string]any {
+		return map[string]any{
+			"id":  strings.TrimPrefix(key, "OPS-"),
+			"key": key,
+			"fields": map[string]any{
+				"summary":     "Rotate credentials for " + key,
+				"description": description,
+				"reporter":    map[string]any{"displayName": "Ops Bot", "emailAddress": "ops@example.com"},
+				"created":     "2024-01-01T00:00:00.000+0000",
+				"attachment":  attachments,
+			},
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		sourcestest.WriteJSON(t, w, []any{
+			map[string]any{"key": "OPS", "name": "Operations"},
+			map[string]any{"key": "HR", "name": "Human Resources"},
+		})
+	})
+	mux.HandleFunc("/rest/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		sourcestest.WriteJSON(t, w, map[string]any{"deploymentType": deploymentType})
+	})
+	searchIssues := func(r *http.Request) []any {
+		jql := r.URL.Query().Get("jql")
+		ts.mu.Lock()
+		ts.queries = append(ts.queries, jql)
+		ts.mu.Unlock()
+
+		var issues []any
+		if !strings.Contains(jql, `key > "OPS-1"`) {
+			issues = append(issues, issue("OPS-1", "password: hunter2", map[string]any{
+				"id":       "10",
+				"filename": "env.txt",
+				"author":   map[string]any{"displayName": "Dev"},
+				"content":  ts.URL + "/secure/attachment/10/env.txt",
+			}))
+		}
+		return append(issues, issue("OPS-2", ""))
+	}
+	// Both search endpoints serve one issue per page to exercise pagination.
+	mux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if deploymentType == deploymentCloud {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		issues := searchIssues(r)
+		startAt := 0
+		if r.URL.Query().Get("startAt") != "0" {
+			startAt = 1
+		}
+		page := issues[min(startAt, len(issues)):min(startAt+1, len(issues))]
+		sourcestest.WriteJSON(t, w, map[string]any{"startAt": startAt, "total": len(issues), "issues": page})
+	})
+	mux.HandleFunc("/rest/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		if deploymentType != deploymentCloud {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		issues := searchIssues(r)
+		start := 0
+		if token := r.URL.Query().Get("nextPageToken"); token != "" {
+			assert.Equal(t, "page-2", token)
+			start = 1
+		}
+		page := issues[min(start, len(issues)):min(start+1, len(issues))]
+		resp := map[string]any{"issues": page, "isLast": start+1 >= len(issues)}
+		if start+1 < len(issues) {
+			resp["nextPageToken"] = "page-2"
+		}
+		sourcestest.WriteJSON(t, w, resp)
+	})
+	mux.HandleFunc("/rest/api/2/issue/OPS-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "0" {
+			sourcestest.WriteJSON(t, w, map[string]any{"startAt": 0, "total": 2, "comments": []any{
+				map[string]any{"id": "100", "body": "first comment", "author": map[string]any{"displayName": "Alice"}},
+			}})
+			return
+		}
+		sourcestest.WriteJSON(t, w, map[string]any{"startAt": 1, "total": 2, "comments": []any{
+			map[string]any{"id": "101", "body": "token=abc123", "author": map[string]any{"displayName": "Bob"}},
+		}})
+	})
+	mux.HandleFunc("/rest/api/2/issue/OPS-2/comment", func(w http.ResponseWriter, r *http.Request) {
+		sourcestest.WriteJSON(t, w, map[string]any{"startAt": 0, "total": 0, "comments": []any{}})
+	})
+	mux.HandleFunc("/secure/attachment/10/env.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("AWS_SECRET=attached\n"))
+	})
+
+	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer pat" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func TestSource_Enumerate(t *testing.T) {
+	server := newTestServer(t, deploymentDataCenter)
+
+	s := sourcestest.InitSource(t, &Source{}, "test - jira", &sourcespb.JIRA{
+		Endpoint:       server.URL,
+		Credential:     &sourcespb.JIRA_Token{Token: "pat"},
+		IgnoreProjects: []string{"hr"},
+	})
+
+	reporter := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), reporter))
+	assert.Empty(t, reporter.UnitErrs)
+	assert.Equal(t, []sources.SourceUnit{
+		sources.CommonSourceUnit{Kind: UnitProject, ID: "OPS"},
+	}, reporter.Units)
+}
+
+func TestSource_ChunkUnit(t *testing.T) {
+	for _, deploymentType := range []string{deploymentDataCenter, deploymentCloud} {
+		t.Run(deploymentType, func(t *testing.T) {
+			server := newTestServer(t, deploymentType)
+
+			s := sourcestest.InitSource(t, &Source{}, "test - jira", &sourcespb.JIRA{
+				Endpoint:   server.URL,
+				Credential: &sourcespb.JIRA_Token{Token: "pat"},
+			})
+
+			reporter := &sourcestest.TestReporter{}
+			unit := sources.CommonSourceUnit{Kind: UnitProject, ID: "OPS"}
+			require.NoError(t, s.ChunkUnit(context.Background(), unit, reporter))
+			assert.Empty(t, reporter.ChunkErrs)
+
+			type result struct{ issue, location, link, author, data string }
+			var got []result
+			for _, chunk := range reporter.Chunks {
+				meta := chunk.SourceMetadata.GetJira()
+				require.NotNil(t, meta)
+				got = append(got, result{meta.GetIssue(), meta.GetLocation(), meta.GetLink(), meta.GetAuthor(), string(chunk.Data)})
+			}
+			assert.Equal(t, []result{
+				{"OPS-1", locationIssue, server.URL + "/browse/OPS-1", "Ops Bot", "Rotate credentials for OPS-1\npassword: hunter2"},
+				{"OPS-1", locationComment, server.URL + "/browse/OPS-1?focusedCommentId=100", "Alice", "first comment"},
+				{"OPS-1", locationComment, server.URL + "/browse/OPS-1?focusedCommentId=101", "Bob", "token=abc123"},
+				{"OPS-1", locationAttachment, server.URL + "/secure/attachment/10/env.txt", "Dev", "AWS_SECRET=attached\n"},
+				{"OPS-2", locationIssue, server.URL + "/browse/OPS-2", "Ops Bot", "Rotate credentials for OPS-2"},
+			}, got)
+
+			// Completing the unit clears its resume info.
+			assert.Empty(t, s.GetEncodedResumeInfoFor("OPS"))
+		})
+	}
+}
+
+func TestSource_ChunkUnit_Resume(t *testing.T) {
+	for _, deploymentType := range []string{deploymentDataCenter, deploymentCloud} {
+		t.Run(deploymentType, func(t *testing.T) {
+			server := newTestServer(t, deploymentType)
+
+			s := sourcestest.InitSource(t, &Source{}, "test - jira", &sourcespb.JIRA{
+				Endpoint:   server.URL,
+				Credential: &sourcespb.JIRA_Token{Token: "pat"},
+			})
+			s.SetEncodedResumeInfoFor("OPS", "OPS-1")
+
+			reporter := &sourcestest.TestReporter{}
+			unit := sources.CommonSourceUnit{Kind: UnitProject, ID: "OPS"}
+			require.NoError(t, s.ChunkUnit(context.Background(), unit, reporter))
+
+			require.Len(t, reporter.Chunks, 1)
+			assert.Equal(t, "OPS-2", reporter.Chunks[0].SourceMetadata.GetJira().GetIssue())
+			require.NotEmpty(t, server.queries)
+			assert.Contains(t, server.queries[0], `key > "OPS-1"`)
+		})
+	}
+}
+
+func TestSource_ChunkUnit_ResumeInfoOnCancel(t *testing.T) {
+	server := newTestServer(t, deploymentDataCenter)
+
+	s := sourcestest.InitSource(t, &Source{}, "test - jira", &sourcespb.JIRA{
+		Endpoint:   server.URL,
+		Credential: &sourcespb.JIRA_Token{Token: "pat"},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reporter := &cancelOnIssue{cancel: cancel, issue: "OPS-2"}
+	unit := sources.CommonSourceUnit{Kind: UnitProject, ID: "OPS"}
+	_ = s.ChunkUnit(ctx, unit, reporter)
+
+	// OPS-1 completed but OPS-2 was interrupted, so a resumed scan starts
+	// after OPS-1.
+	assert.Equal(t, "OPS-1", s.GetEncodedResumeInfoFor("OPS"))
+}
+
+// cancelOnIssue cancels the context when the given issue is reported.
+type cancelOnIssue struct {
+	sourcestest.TestReporter
+	cancel context.CancelFunc
+	issue  string
+}
+
+func (r *cancelOnIssue) ChunkOk(ctx context.Context, chunk sources.Chunk) error {
+	if chunk.SourceMetadata.GetJira().GetIssue() == r.issue {
+		r.cancel()
+	}
+	return r.TestReporter.ChunkOk(ctx, chunk)
+}
+
+func TestSource_BuildJQL(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		jql         string
+		since       time.Time
+		resumeAfter string
+		want        string
+	}{
+		{
+			name: "project only",
+			want: `project = "OPS" ORDER BY key ASC`,
+		},
+		{
+			name: "custom jql",
+			jql:  "labels = ops OR priority = High",
+			want: `project = "OPS" AND (labels = ops OR priority = High) ORDER BY key ASC`,
+		},
+		{
+			name:  "since",
+			since: now.Add(-90 * time.Minute),
+			want:  `project = "OPS" AND updated >= "-90m" ORDER BY key ASC`,
+		},
+		{
+			name:        "all",
+			jql:         "labels = ops",
+			since:       now.Add(-30 * time.Second),
+			resumeAfter: "OPS-42",
+			want:        `project = "OPS" AND (labels = ops) AND updated >= "-1m" AND key > "OPS-42" ORDER BY key ASC`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Source{jql: tt.jql, since: tt.since}
+			assert.Equal(t, tt.want, s.buildJQL("OPS", tt.resumeAfter, now))
+		})
+	}
+}
+
+func TestSource_InitRejectsOrderBy(t *testing.T) {
+	anyConn, err := anypb.New(&sourcespb.JIRA{
+		Endpoint:   "https://jira.example.com",
+		Credential: &sourcespb.JIRA_Token{Token: "pat"},
+		Jql:        "labels = ops order by created",
+	})
+	require.NoError(t, err)
+
+	s := &Source{}
+	assert.Error(t, s.Init(context.Background(), "test - jira", 0, 0, false, anyConn, 1))
+}
+
+func TestSource_InitResolvesRelativeSince(t *testing.T) {
+	conn := &sourcespb.JIRA{
+		Endpoint:   "https://jira.example.com",
+		Credential: &sourcespb.JIRA_Token{Token: "pat"},
+		Since:      "720h",
+	}
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+
+	// The connection keeps the relative value, so it is the same on every run
+	// and a checkpoint saved for it can be resumed. The source resolves it
+	// when it is initialized.
+	before := time.Now()
+	s := &Source{}
+	require.NoError(t, s.Init(context.Background(), "test - jira", 0, 0, false, anyConn, 1))
+	assert.WithinRange(t, s.since, before.Add(-720*time.Hour), time.Now().Add(-720*time.Hour))
+
+	anyConn, err = anypb.New(&sourcespb.JIRA{
+		Endpoint:   "https://jira.example.com",
+		Credential: &sourcespb.JIRA_Token{Token: "pat"},
+		Since:      "last week",
+	})
+	require.NoError(t, err)
+	assert.Error(t, (&Source{}).Init(context.Background(), "test - jira", 0, 0, false, anyConn, 1))
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: ""},
+		{value: "2024-05-01", want: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "2024-05-01T08:30:00Z", want: time.Date(2024, 5, 1, 8, 30, 0, 0, time.UTC)},
+		{value: "36h", want: now.Add(-36 * time.Hour)},
+		{value: "-1h", wantErr: true},
+		{value: "yesterday", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %v, want %v", got, tt.want)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package slack
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+)
+
+const (
+	defaultAPIURL = "https://slack.com/api"
+
+	// paginationLimit is the page size for conversations.* methods. Slack
+	// recommends no more than 200.
+	paginationLimit = 200
+)
+
+// apiResponse holds the fields common to every Slack Web API response.
+type apiResponse struct {
+	OK               bool   `json:"ok"`
+	Error            string `json:"error"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+type authTestResponse struct {
+	apiResponse
+	URL  string `json:"url"`
+	Team string `json:"team"`
+}
+
+type conversationsListResponse struct {
+	apiResponse
+	Channels []channel `json:"channels"`
+}
+
+type conversationInfoResponse struct {
+	apiResponse
+	Channel channel `json:"channel"`
+}
+
+type messagesResponse struct {
+	apiResponse
+	Messages []message `json:"messages"`
+	HasMore  bool      `json:"has_more"`
+}
+
+// apiClient is a minimal Slack Web API client.
+type apiClient struct {
+	httpClient *http.Client
+	apiURL     *url.URL
+	token      string
+}
+
+// authTest returns the workspace URL of the token, e.g. https://acme.slack.com/.
+func (c *apiClient) authTest(ctx context.Context) (string, error) {
+	var resp authTestResponse
+	if err := c.call(ctx, "auth.test", nil, &resp); err != nil {
+		return "", err
+	}
+	return resp.URL, nil
+}
+
+// listConversations calls visit for every public and private channel the
+// token can see.
+func (c *apiClient) listConversations(ctx context.Context, visit func(channel) error) error {
+	params := url.Values{
+		"types":            {"public_channel,private_channel"},
+		"exclude_archived": {"false"},
+		"limit":            {strconv.Itoa(paginationLimit)},
+	}
+	for {
+		var resp conversationsListResponse
+		if err := c.call(ctx, "conversations.list", params, &resp); err != nil {
+			return err
+		}
+		for _, ch := range resp.Channels {
+			if err := visit(ch); err != nil {
+				return err
+			}
+		}
+		if resp.ResponseMetadata.NextCursor == "" {
+			return nil
+		}
+		params.Set("cursor", resp.ResponseMetadata.NextCursor)
+	}
+}
+
+// conversationInfo returns the channel with the given ID.
+func (c *apiClient) conversationInfo(ctx context.Context, id string) (channel, error) {
+	var resp conversationInfoResponse
+	err := c.call(ctx, "conversations.info", url.Values{"channel": {id}}, &resp)
+	return resp.Channel, err
+}
+
+// history calls visit for every message in a channel, newest first.
+func (c *apiClient) history(ctx context.Context, channelID string, visit func(message) error) error {
+	params := url.Values{
+		"channel": {channelID},
+		"limit":   {strconv.Itoa(paginationLimit)},
+	}
+	return c.paginateMessages(ctx, "conversations.history", params, visit)
+}
+
+// replies calls visit for every reply in a thread. The parent message, which
+// Slack returns first, is skipped.
+func (c *apiClient) replies(ctx context.Context, channelID, threadTS string, visit func(message) error) error {
+	params := url.Values{
+		"channel": {channelID},
+		"ts":      {threadTS},
+		"limit":   {strconv.Itoa(paginationLimit)},
+	}
+	return c.paginateMessages(ctx, "conversations.replies", params, func(m message) error {
+		if m.TS == threadTS {
+			return nil
+		}
+		return visit(m)
+	})
+}
+
+func (c *apiClient) paginateMessages(ctx context.Context, method string, params url.Values, visit func(message) error) error {
+	for {
+		var resp messagesResponse
+		if err := c.call(ctx, method, params, &resp); err != nil {
+			return err
+		}
+		for _, m := range resp.Messages {
+			if err := visit(m); err != nil {
+				return err
+			}
+		}
+		if !resp.HasMore || resp.ResponseMetadata.NextCursor == "" {
+			return nil
+		}
+		params.Set("cursor", resp.ResponseMetadata.NextCursor)
+	}
+}
+
+// download opens a private file URL. The caller must close the body.
+func (c *apiClient) download(ctx context.Context, fileURL string) (io.ReadCloser, error) {
+	resp, err := c.do(ctx, fileURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("unexpected status code from Slack: %d", resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+// call invokes a Web API method and decodes the response into target, which
+// must embed apiResponse. Responses with ok=false are returned as errors.
+func (c *apiClient) call(ctx context.Context, method string, params url.Values, target interface{ result() apiResponse }) error {
+	u := c.apiURL.JoinPath(method)
+	u.RawQuery = params.Encode()
+
+	resp, err := c.do(ctx, u.String())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code from Slack API method %s: %d", method, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return fmt.Errorf("failed to decode Slack API response: %w", err)
+	}
+	if r := target.result(); !r.OK {
+		return fmt.Errorf("slack API method %s failed: %s", method, r.Error)
+	}
+	return nil
+}
+
+func (r apiResponse) result() apiResponse { return r }
+
+func (c *apiClient) do(ctx context.Context, reqURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Slack request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	ctx.Logger().V(4).Info("executing query", "query_url", reqURL)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request to Slack: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,248 @@
+package slack
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+)
+
+type channel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	IsPrivate   bool   `json:"is_private"`
+	IsIM        bool   `json:"is_im"`
+	IsMpIM      bool   `json:"is_mpim"`
+	IsExtShared bool   `json:"is_ext_shared"`
+}
+
+func (c channel) visibility() source_metadatapb.Visibility {
+	switch {
+	case c.IsExtShared:
+		return source_metadatapb.Visibility_shared
+	case c.IsPrivate || c.IsIM || c.IsMpIM:
+		return source_metadatapb.Visibility_private
+	default:
+		return source_metadatapb.Visibility_public
+	}
+}
+
+type user struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Profile struct {
+		Email string `json:"email"`
+	} `json:"profile"`
+}
+
+type file struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Title              string `json:"title"`
+	Mode               string `json:"mode"`
+	URLPrivateDownload string `json:"url_private_download"`
+}
+
+type message struct {
+	Type        string `json:"type"`
+	Subtype     string `json:"subtype"`
+	User        string `json:"user"`
+	Text        string `json:"text"`
+	TS          string `json:"ts"`
+	ThreadTS    string `json:"thread_ts"`
+	ReplyCount  int    `json:"reply_count"`
+	Files       []file `json:"files"`
+	Attachments []struct {
+		Pretext   string `json:"pretext"`
+		Title     string `json:"title"`
+		TitleLink string `json:"title_link"`
+		Text      string `json:"text"`
+		Fallback  string `json:"fallback"`
+		Footer    string `json:"footer"`
+	} `json:"attachments"`
+}
+
+// content returns the scannable text of a message: its body and the text of
+// any link unfurls or bot attachments.
+func (m message) content() string {
+	parts := []string{m.Text}
+	for _, a := range m.Attachments {
+		parts = append(parts, a.Pretext, a.Title, a.TitleLink, a.Text, a.Footer)
+		if a.Text == "" {
+			parts = append(parts, a.Fallback)
+		}
+	}
+	var nonEmpty []string
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, "\n")
+}
+
+// exportChannelFiles lists the metadata files of a workspace export and the
+// visibility of the conversations they describe. Direct messages are stored
+// in directories named after their ID rather than a name.
+var exportChannelFiles = []struct {
+	name  string
+	byID  bool
+	apply func(*channel)
+}{
+	{name: "channels.json"},
+	{name: "groups.json", apply: func(c *channel) { c.IsPrivate = true }},
+	{name: "mpims.json", apply: func(c *channel) { c.IsMpIM = true }},
+	{name: "dms.json", byID: true, apply: func(c *channel) { c.IsIM = true }},
+}
+
+// export is a Slack workspace export, either the zip file Slack produces or
+// a directory it was extracted to.
+//
+// An export contains channels.json (and groups.json, mpims.json and dms.json
+// for exports that include private conversations), users.json, and one
+// directory per conversation holding a JSON file of messages per day. Any
+// other files in a conversation directory, such as downloaded uploads, are
+// treated as files shared in it.
+type export struct {
+	path string
+	// channels is keyed by the name of the conversation's directory.
+	channels map[string]channel
+	// dirs lists the conversation directories in the order they were found.
+	dirs  []string
+	users map[string]user
+}
+
+// loadExport reads the metadata of the export at exportPath. The export's
+// files are only kept open while they are read.
+func loadExport(exportPath string) (*export, error) {
+	e := &export{path: exportPath, channels: make(map[string]channel), users: make(map[string]user)}
+	fsys, closeFS, err := e.open()
+	if err != nil {
+		return nil, err
+	}
+	defer closeFS()
+
+	if err := e.load(fsys); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// open opens the files of the export. The returned function closes them.
+func (e *export) open() (fs.FS, func(), error) {
+	info, err := os.Stat(e.path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open Slack export: %w", err)
+	}
+	if info.IsDir() {
+		return os.DirFS(e.path), func() {}, nil
+	}
+	zr, err := zip.OpenReader(e.path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to open Slack export zip: %w", err)
+	}
+	return zr, func() { _ = zr.Close() }, nil
+}
+
+func (e *export) load(fsys fs.FS) error {
+	for _, cf := range exportChannelFiles {
+		var channels []channel
+		found, err := readJSON(fsys, cf.name, &channels)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+		for _, ch := range channels {
+			if cf.apply != nil {
+				cf.apply(&ch)
+			}
+			dir := ch.Name
+			if cf.byID || dir == "" {
+				dir = ch.ID
+			}
+			if _, ok := e.channels[dir]; ok || dir == "" {
+				continue
+			}
+			e.channels[dir] = ch
+			e.dirs = append(e.dirs, dir)
+		}
+	}
+	if len(e.channels) == 0 {
+		return fmt.Errorf("not a Slack export: no conversations found in channels.json, groups.json, mpims.json or dms.json")
+	}
+
+	var users []user
+	if _, err := readJSON(fsys, "users.json", &users); err != nil {
+		return err
+	}
+	for _, u := range users {
+		e.users[u.ID] = u
+	}
+	return nil
+}
+
+// readJSON decodes a JSON file from the export. It reports whether the file
+// exists.
+func readJSON(fsys fs.FS, name string, target any) (bool, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to open %s: %w", name, err)
+	}
+	defer f.Close()
+
+	if err := json.NewDecoder(f).Decode(target); err != nil {
+		return true, fmt.Errorf("unable to decode %s: %w", name, err)
+	}
+	return true, nil
+}
+
+// walkChannel calls onMessages with the messages of each daily JSON file in a
+// conversation directory, and onFile for every other file in it. The export
+// is opened for the walk and closed once it returns.
+func (e *export) walkChannel(dir string, onMessages func(name string, msgs []message) error, onFile func(name string, r io.Reader) error) error {
+	fsys, closeFS, err := e.open()
+	if err != nil {
+		return err
+	}
+	defer closeFS()
+
+	return fs.WalkDir(fsys, dir, func(p string, d fs.DirEntry, err error) error {
+		if p == dir && errors.Is(err, fs.ErrNotExist) {
+			// Conversations without messages have no directory.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if path.Dir(p) == dir && path.Ext(p) == ".json" {
+			var msgs []message
+			if _, err := readJSON(fsys, p, &msgs); err != nil {
+				return err
+			}
+			return onMessages(p, msgs)
+		}
+
+		f, err := fsys.Open(p)
+		if err != nil {
+			return fmt.Errorf("unable to open %s: %w", p, err)
+		}
+		defer f.Close()
+		return onFile(p, f)
+	})
+}
@@ -0,0 +1,437 @@
+package slack
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/handlers"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/log"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/roundtripper"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sanitizer"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+)
+
+const SourceType = sourcespb.SourceType_SOURCE_TYPE_SLACK
+
+// UnitChannel is the SourceUnitKind of a Slack conversation. In API mode the
+// unit ID is the channel ID; in export mode it is the conversation's
+// directory name in the export.
+const UnitChannel sources.SourceUnitKind = "channel"
+
+// Locations reported in the Slack source metadata.
+const (
+	locationMessage = "message"
+	locationFile    = "file"
+)
+
+type Source struct {
+	name   string
+	id     sources.SourceID
+	jobID  sources.JobID
+	verify bool
+
+	channels   map[string]struct{}
+	ignoreList map[string]struct{}
+
+	// workspaceURL is used to build links to messages, e.g. https://acme.slack.com/.
+	workspaceURL string
+
+	// exportPath is set in export mode.
+	exportPath string
+	exportOnce sync.Once
+	export     *export
+	exportErr  error
+
+	// api is set in API mode.
+	api *apiClient
+
+	sources.Progress
+	sources.CommonSourceUnitUnmarshaller
+}
+
+// Ensure the Source satisfies the interfaces at compile time.
+var _ sources.Source = (*Source)(nil)
+var _ sources.SourceUnitUnmarshaller = (*Source)(nil)
+var _ sources.Validator = (*Source)(nil)
+var _ sources.SourceUnitEnumChunker = (*Source)(nil)
+
+// Type returns the type of source.
+// It is used for matching source types in configuration and job input.
+func (s *Source) Type() sourcespb.SourceType {
+	return SourceType
+}
+
+func (s *Source) SourceID() sources.SourceID {
+	return s.id
+}
+
+func (s *Source) JobID() sources.JobID {
+	return s.jobID
+}
+
+// Init returns an initialized Slack source.
+func (s *Source) Init(ctx context.Context, name string, jobId sources.JobID, sourceId sources.SourceID, verify bool, connection *anypb.Any, _ int) error {
+	s.name = name
+	s.id = sourceId
+	s.jobID = jobId
+	s.verify = verify
+
+	var conn sourcespb.Slack
+	if err := anypb.UnmarshalTo(connection, &conn, proto.UnmarshalOptions{}); err != nil {
+		return fmt.Errorf("error unmarshalling connection: %w", err)
+	}
+
+	s.channels = toSet(conn.GetChannels())
+	s.ignoreList = toSet(conn.GetIgnoreList())
+
+	// An export path makes the source read a workspace export zip, or a
+	// directory it was extracted to, instead of calling the Slack API.
+	s.exportPath = conn.GetExportPath()
+	s.workspaceURL = conn.GetWorkspaceUrl()
+
+	if s.exportPath != "" {
+		ctx.Logger().V(1).Info("initialized Slack source", "mode", "export", "export_path", s.exportPath)
+		return nil
+	}
+
+	var token string
+	switch cred := conn.GetCredential().(type) {
+	case *sourcespb.Slack_Token:
+		token = cred.Token
+	case *sourcespb.Slack_Tokens:
+		token = cred.Tokens.GetBotToken()
+		if token == "" {
+			token = cred.Tokens.GetClientToken()
+		}
+	}
+	if token == "" {
+		return fmt.Errorf("a token is required for Slack source %q unless scanning an export", name)
+	}
+	log.RedactGlobally(token)
+
+	endpoint := conn.GetEndpoint()
+	if endpoint == "" {
+		endpoint = defaultAPIURL
+	}
+	apiURL, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return fmt.Errorf("invalid endpoint URL given for Slack source: %q: %w", endpoint, err)
+	}
+
+	const retryDelay = time.Second * 30
+	s.api = &apiClient{
+		httpClient: &http.Client{
+			Transport: roundtripper.NewRoundTripper(nil,
+				roundtripper.WithLogger(ctx.Logger()),
+				roundtripper.WithLogging(),
+				roundtripper.WithRetryable(
+					roundtripper.WithShouldRetry5XXDuration(retryDelay),
+				),
+			),
+		},
+		apiURL: apiURL,
+		token:  token,
+	}
+
+	if s.workspaceURL == "" {
+		if s.workspaceURL, err = s.api.authTest(ctx); err != nil {
+			ctx.Logger().Error(err, "could not look up workspace URL; message links will be empty")
+		}
+	}
+
+	ctx.Logger().V(1).Info("initialized Slack source", "mode", "api", "api_url", apiURL.String(), "workspace_url", s.workspaceURL)
+	return nil
+}
+
+func toSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[strings.TrimPrefix(v, "#")] = struct{}{}
+	}
+	return set
+}
+
+// Validate checks that the export can be read or the token can reach the
+// Slack API.
+func (s *Source) Validate(ctx context.Context) []error {
+	if s.exportPath != "" {
+		if _, err := s.loadExport(); err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+	if _, err := s.api.authTest(ctx); err != nil {
+		return []error{fmt.Errorf("slack authentication failed: %w", err)}
+	}
+	return nil
+}
+
+// loadExport reads the metadata of the configured export once and keeps it
+// for the lifetime of the source.
+func (s *Source) loadExport() (*export, error) {
+	s.exportOnce.Do(func() {
+		s.export, s.exportErr = loadExport(s.exportPath)
+	})
+	return s.export, s.exportErr
+}
+
+// Chunks emits chunks of bytes over a channel.
+func (s *Source) Chunks(ctx context.Context, chunksChan chan *sources.Chunk, _ ...sources.ChunkingTarget) error {
+	var units []sources.SourceUnit
+	visitor := sources.VisitorReporter{
+		VisitUnit: func(ctx context.Context, unit sources.SourceUnit) error {
+			units = append(units, unit)
+			return ctx.Err()
+		},
+	}
+	if err := s.Enumerate(ctx, visitor); err != nil {
+		return err
+	}
+
+	reporter := sources.ChanReporter{Ch: chunksChan}
+	for i, unit := range units {
+		if common.IsDone(ctx) {
+			return nil
+		}
+		s.SetProgressComplete(i, len(units), fmt.Sprintf("Channel: %s", unit.Display()), "")
+		if err := s.ChunkUnit(ctx, unit, reporter); err != nil {
+			ctx.Logger().Error(err, "error scanning channel", "channel", unit.Display())
+		}
+	}
+
+	s.SetProgressComplete(len(units), len(units), fmt.Sprintf("Completed scanning source %s", s.name), "")
+	return nil
+}
+
+// include reports whether a channel passes the configured channel and ignore
+// lists, which may contain channel names or IDs.
+func (s *Source) include(ch channel) bool {
+	_, ignoredID := s.ignoreList[ch.ID]
+	_, ignoredName := s.ignoreList[ch.Name]
+	if ignoredID || ignoredName {
+		return false
+	}
+	if len(s.channels) == 0 {
+		return true
+	}
+	_, byID := s.channels[ch.ID]
+	_, byName := s.channels[ch.Name]
+	return byID || byName
+}
+
+// Enumerate reports the Slack conversations to be scanned to the reporter.
+func (s *Source) Enumerate(ctx context.Context, reporter sources.UnitReporter) error {
+	if s.exportPath != "" {
+		exp, err := s.loadExport()
+		if err != nil {
+			return reporter.UnitErr(ctx, err)
+		}
+		for _, dir := range exp.dirs {
+			if !s.include(exp.channels[dir]) {
+				continue
+			}
+			if err := reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitChannel, ID: dir}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := s.api.listConversations(ctx, func(ch channel) error {
+		if !s.include(ch) {
+			return nil
+		}
+		return reporter.UnitOk(ctx, sources.CommonSourceUnit{Kind: UnitChannel, ID: ch.ID})
+	})
+	if err != nil {
+		return reporter.UnitErr(ctx, fmt.Errorf("error listing conversations: %w", err))
+	}
+	return nil
+}
+
+// ChunkUnit reports a chunk for every message in a conversation, including
+// thread replies, and for every file shared in it.
+func (s *Source) ChunkUnit(ctx context.Context, unit sources.SourceUnit, reporter sources.ChunkReporter) error {
+	id, _ := unit.SourceUnitID()
+	ctx = context.WithValue(ctx, "channel", id)
+
+	if s.exportPath != "" {
+		return s.chunkExportChannel(ctx, id, reporter)
+	}
+	return s.chunkAPIChannel(ctx, id, reporter)
+}
+
+func (s *Source) chunkExportChannel(ctx context.Context, dir string, reporter sources.ChunkReporter) error {
+	exp, err := s.loadExport()
+	if err != nil {
+		return reporter.ChunkErr(ctx, err)
+	}
+	ch, ok := exp.channels[dir]
+	if !ok {
+		return reporter.ChunkErr(ctx, fmt.Errorf("conversation %q not found in export", dir))
+	}
+
+	onMessages := func(_ string, msgs []message) error {
+		for _, m := range msgs {
+			if common.IsDone(ctx) {
+				return ctx.Err()
+			}
+			email := exp.users[m.User].Profile.Email
+			if err := s.reportMessage(ctx, ch, m, email, reporter); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	onFile := func(name string, r io.Reader) error {
+		meta := s.metadata(ch, message{}, "", locationFile)
+		meta.GetSlack().File = sanitizer.UTF8(path.Base(name))
+		chunkSkel := s.chunk(meta, nil)
+		if err := handlers.HandleFile(ctx, r, &chunkSkel, reporter); err != nil {
+			return reporter.ChunkErr(ctx, fmt.Errorf("error scanning file %q: %w", name, err))
+		}
+		return nil
+	}
+
+	if err := exp.walkChannel(dir, onMessages, onFile); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error reading conversation %q: %w", dir, err))
+	}
+	return nil
+}
+
+func (s *Source) chunkAPIChannel(ctx context.Context, channelID string, reporter sources.ChunkReporter) error {
+	ch, err := s.api.conversationInfo(ctx, channelID)
+	if err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error getting conversation %q: %w", channelID, err))
+	}
+
+	var visit func(m message) error
+	visit = func(m message) error {
+		if common.IsDone(ctx) {
+			return ctx.Err()
+		}
+		if err := s.reportMessage(ctx, ch, m, "", reporter); err != nil {
+			return err
+		}
+		for _, f := range m.Files {
+			if err := s.chunkAPIFile(ctx, ch, m, f, reporter); err != nil {
+				if err := reporter.ChunkErr(ctx, err); err != nil {
+					return err
+				}
+			}
+		}
+		// conversations.history only returns the parent message of a thread.
+		if m.ReplyCount > 0 && m.ThreadTS == m.TS {
+			if err := s.api.replies(ctx, ch.ID, m.TS, visit); err != nil {
+				return reporter.ChunkErr(ctx, fmt.Errorf("error getting replies to %s: %w", m.TS, err))
+			}
+		}
+		return nil
+	}
+
+	if err := s.api.history(ctx, ch.ID, visit); err != nil {
+		return reporter.ChunkErr(ctx, fmt.Errorf("error getting history of conversation %q: %w", channelID, err))
+	}
+	return nil
+}
+
+func (s *Source) chunkAPIFile(ctx context.Context, ch channel, m message, f file, reporter sources.ChunkReporter) error {
+	// External files (e.g. Google Drive links) and deleted files have no
+	// content hosted by Slack.
+	if f.URLPrivateDownload == "" || f.Mode == "external" || f.Mode == "tombstone" {
+		return nil
+	}
+
+	body, err := s.api.download(ctx, f.URLPrivateDownload)
+	if err != nil {
+		return fmt.Errorf("error downloading file %q: %w", f.Name, err)
+	}
+	defer body.Close()
+
+	meta := s.metadata(ch, m, "", locationFile)
+	meta.GetSlack().File = sanitizer.UTF8(f.Name)
+	chunkSkel := s.chunk(meta, nil)
+	return handlers.HandleFile(ctx, body, &chunkSkel, reporter)
+}
+
+func (s *Source) reportMessage(ctx context.Context, ch channel, m message, email string, reporter sources.ChunkReporter) error {
+	text := m.content()
+	if text == "" {
+		return nil
+	}
+	return reporter.ChunkOk(ctx, s.chunk(s.metadata(ch, m, email, locationMessage), []byte(text)))
+}
+
+func (s *Source) chunk(meta *source_metadatapb.MetaData, data []byte) sources.Chunk {
+	return sources.Chunk{
+		SourceName:     s.name,
+		SourceID:       s.SourceID(),
+		JobID:          s.JobID(),
+		SourceType:     s.Type(),
+		SourceMetadata: meta,
+		Data:           data,
+		Verify:         s.verify,
+	}
+}
+
+func (s *Source) metadata(ch channel, m message, email, location string) *source_metadatapb.MetaData {
+	return &source_metadatapb.MetaData{
+		Data: &source_metadatapb.MetaData_Slack{
+			Slack: &source_metadatapb.Slack{
+				ChannelId:   ch.ID,
+				ChannelName: sanitizer.UTF8(ch.Name),
+				Timestamp:   formatTS(m.TS),
+				UserId:      m.User,
+				Link:        s.messageLink(ch.ID, m),
+				Email:       sanitizer.UTF8(email),
+				Visibility:  ch.visibility(),
+				Location:    location,
+			},
+		},
+	}
+}
+
+// messageLink returns a permalink to a message. It is empty if the workspace
+// URL is unknown.
+func (s *Source) messageLink(channelID string, m message) string {
+	if s.workspaceURL == "" || channelID == "" || m.TS == "" {
+		return ""
+	}
+	u, err := url.Parse(s.workspaceURL)
+	if err != nil {
+		return ""
+	}
+	u = u.JoinPath("archives", channelID, "p"+strings.Replace(m.TS, ".", "", 1))
+	if m.ThreadTS != "" && m.ThreadTS != m.TS {
+		u.RawQuery = url.Values{"thread_ts": {m.ThreadTS}, "cid": {channelID}}.Encode()
+	}
+	return u.String()
+}
+
+// formatTS converts a Slack message timestamp, which is a Unix time with
+// microseconds such as "1700000000.000100", to RFC 3339.
+func formatTS(ts string) string {
+	if ts == "" {
+		return ""
+	}
+	secs, _, _ := strings.Cut(ts, ".")
+	n, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return ts
+	}
+	return time.Unix(n, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,287 @@
+package slack
+
+import (
+	"archive/zip"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/source_metadatapb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/pb/sourcespb"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
+	"github.com/trufflesecurity/trufflehog/v3/pkg/sourcestest"
+)
+
+const exportDir = "testdata/export"
+
+// zipDir writes the contents of dir to a zip file and returns its path.
+func zipDir(t *testing.T, dir string) string {
+	t.Helper()
+
+	zipPath := filepath.Join(t.TempDir(), "export.zip")
+	f, err := os.Create(zipPath)
+	require.NoError(t, err)
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		w, err := zw.Create(filepath.ToSlash(rel))
+		if err != nil {
+			return err
+		}
+		src, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(w, src)
+		return err
+	})
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return zipPath
+}
+
+type result struct {
+	channel, user, email, location, link, file, data string
+	visibility                                       source_metadatapb.Visibility
+}
+
+func collect(t *testing.T, chunks []sources.Chunk) []result {
+	t.Helper()
+	var got []result
+	for _, chunk := range chunks {
+		meta := chunk.SourceMetadata.GetSlack()
+		require.NotNil(t, meta)
+		got = append(got, result{
+			channel:    meta.GetChannelName(),
+			user:       meta.GetUserId(),
+			email:      meta.GetEmail(),
+			location:   meta.GetLocation(),
+			link:       meta.GetLink(),
+			file:       meta.GetFile(),
+			data:       string(chunk.Data),
+			visibility: meta.GetVisibility(),
+		})
+	}
+	return got
+}
+
+func TestSource_Export(t *testing.T) {
+	for name, exportPath := range map[string]string{
+		"directory": exportDir,
+		"zip":       zipDir(t, exportDir),
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := sourcestest.InitSource(t, &Source{}, "test - slack", &sourcespb.Slack{
+				IgnoreList:   []string{"#random"},
+				ExportPath:   exportPath,
+				WorkspaceUrl: "https://acme.slack.com",
+			})
+			require.Empty(t, s.Validate(context.Background()))
+
+			reporter := &sourcestest.TestReporter{}
+			require.NoError(t, s.Enumerate(context.Background(), reporter))
+			assert.Empty(t, reporter.UnitErrs)
+			assert.Equal(t, []sources.SourceUnit{
+				sources.CommonSourceUnit{Kind: UnitChannel, ID: "general"},
+				sources.CommonSourceUnit{Kind: UnitChannel, ID: "secret-ops"},
+			}, reporter.Units)
+
+			for _, unit := range reporter.Units {
+				require.NoError(t, s.ChunkUnit(context.Background(), unit, reporter))
+			}
+			assert.Empty(t, reporter.ChunkErrs)
+
+			assert.Equal(t, []result{
+				{
+					channel: "general", user: "U01ALICE", email: "alice@example.com", location: locationMessage,
+					link: "https://acme.slack.com/archives/C01GENERAL/p1704103200000100",
+					data: "the staging db password is hunter2",
+				},
+				{
+					channel: "general", user: "U02BOB", email: "bob@example.com", location: locationMessage,
+					link: "https://acme.slack.com/archives/C01GENERAL/p1704103260000200",
+					data: "Deploy config\nhttps://ci.example.com/job/1\nAPI_KEY=example",
+				},
+				{
+					channel: "general", user: "U01ALICE", email: "alice@example.com", location: locationMessage,
+					link: "https://acme.slack.com/archives/C01GENERAL/p1704103320000300?cid=C01GENERAL&thread_ts=1704103200.000100",
+					data: "thanks, rotated",
+				},
+				{
+					channel: "general", location: locationFile, file: "F01-creds.txt",
+					data: "aws_secret_access_key = example\n",
+				},
+				{
+					channel: "secret-ops", user: "U02BOB", email: "bob@example.com", location: locationMessage,
+					link:       "https://acme.slack.com/archives/G01SECRET/p1704189600000100",
+					data:       "vault token: s.example",
+					visibility: source_metadatapb.Visibility_private,
+				},
+			}, collect(t, reporter.Chunks))
+
+			// The export is only open while it is read.
+			assert.Empty(t, openFiles(t, exportPath))
+		})
+	}
+}
+
+// openFiles returns the files under root that the process has open. It
+// returns nil where open files can't be listed.
+func openFiles(t *testing.T, root string) []string {
+	t.Helper()
+	fds, err := os.ReadDir("/proc/self/fd")
+	if err != nil {
+		return nil
+	}
+	root, err = filepath.Abs(root)
+	require.NoError(t, err)
+	var open []string
+	for _, fd := range fds {
+		target, err := os.Readlink(filepath.Join("/proc/self/fd", fd.Name()))
+		if err == nil && strings.HasPrefix(target, root) {
+			open = append(open, target)
+		}
+	}
+	return open
+}
+
+func TestSource_ExportChannelFilter(t *testing.T) {
+	s := sourcestest.InitSource(t, &Source{}, "test - slack", &sourcespb.Slack{Channels: []string{"G01SECRET"}, ExportPath: exportDir})
+
+	reporter := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), reporter))
+	assert.Equal(t, []sources.SourceUnit{
+		sources.CommonSourceUnit{Kind: UnitChannel, ID: "secret-ops"},
+	}, reporter.Units)
+}
+
+func TestSource_ExportInvalid(t *testing.T) {
+	s := sourcestest.InitSource(t, &Source{}, "test - slack", &sourcespb.Slack{ExportPath: t.TempDir()})
+	assert.Len(t, s.Validate(context.Background()), 1)
+}
+
+func TestSource_API(t *testing.T) {
+	var server *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth.test", func(w http.ResponseWriter, r *http.Request) {
+		sourcestest.WriteJSON(t, w, map[string]any{"ok": true, "url": "https://acme.slack.com/"})
+	})
+	mux.HandleFunc("/api/conversations.list", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			sourcestest.WriteJSON(t, w, map[string]any{
+				"ok":                true,
+				"channels":          []any{map[string]any{"id": "C01", "name": "general"}},
+				"response_metadata": map[string]any{"next_cursor": "page2"},
+			})
+			return
+		}
+		sourcestest.WriteJSON(t, w, map[string]any{
+			"ok":       true,
+			"channels": []any{map[string]any{"id": "C02", "name": "random"}},
+		})
+	})
+	mux.HandleFunc("/api/conversations.info", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "C01", r.URL.Query().Get("channel"))
+		sourcestest.WriteJSON(t, w, map[string]any{"ok": true, "channel": map[string]any{"id": "C01", "name": "general"}})
+	})
+	mux.HandleFunc("/api/conversations.history", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("cursor") == "" {
+			sourcestest.WriteJSON(t, w, map[string]any{
+				"ok": true,
+				"messages": []any{map[string]any{
+					"user": "U01", "text": "see thread", "ts": "1700000000.000100",
+					"thread_ts": "1700000000.000100", "reply_count": 1,
+				}},
+				"has_more":          true,
+				"response_metadata": map[string]any{"next_cursor": "older"},
+			})
+			return
+		}
+		sourcestest.WriteJSON(t, w, map[string]any{
+			"ok": true,
+			"messages": []any{map[string]any{
+				"user": "U02", "text": "uploaded", "ts": "1690000000.000100",
+				"files": []any{
+					map[string]any{"id": "F1", "name": "env.txt", "mode": "hosted", "url_private_download": server.URL + "/files/F1/env.txt"},
+					map[string]any{"id": "F2", "name": "doc", "mode": "external", "url_private_download": server.URL + "/files/F2"},
+				},
+			}},
+		})
+	})
+	mux.HandleFunc("/api/conversations.replies", func(w http.ResponseWriter, r *http.Request) {
+		sourcestest.WriteJSON(t, w, map[string]any{
+			"ok": true,
+			"messages": []any{
+				map[string]any{"user": "U01", "text": "see thread", "ts": "1700000000.000100", "thread_ts": "1700000000.000100"},
+				map[string]any{"user": "U02", "text": "token=reply", "ts": "1700000100.000200", "thread_ts": "1700000000.000100"},
+			},
+		})
+	})
+	mux.HandleFunc("/files/F1/env.txt", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("SECRET=file\n"))
+	})
+	mux.HandleFunc("/files/F2", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("external files should not be downloaded")
+	})
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer xoxb-test" {
+			sourcestest.WriteJSON(t, w, map[string]any{"ok": false, "error": "invalid_auth"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	s := sourcestest.InitSource(t, &Source{}, "test - slack", &sourcespb.Slack{
+		Endpoint:   server.URL + "/api",
+		Credential: &sourcespb.Slack_Token{Token: "xoxb-test"},
+		Channels:   []string{"general"},
+	})
+	require.Empty(t, s.Validate(context.Background()))
+
+	reporter := &sourcestest.TestReporter{}
+	require.NoError(t, s.Enumerate(context.Background(), reporter))
+	assert.Equal(t, []sources.SourceUnit{
+		sources.CommonSourceUnit{Kind: UnitChannel, ID: "C01"},
+	}, reporter.Units)
+
+	require.NoError(t, s.ChunkUnit(context.Background(), reporter.Units[0], reporter))
+	assert.Empty(t, reporter.ChunkErrs)
+	assert.Equal(t, []result{
+		{channel: "general", user: "U01", location: locationMessage, link: "https://acme.slack.com/archives/C01/p1700000000000100", data: "see thread"},
+		{channel: "general", user: "U02", location: locationMessage, link: "https://acme.slack.com/archives/C01/p1700000100000200?cid=C01&thread_ts=1700000000.000100", data: "token=reply"},
+		{channel: "general", user: "U02", location: locationMessage, link: "https://acme.slack.com/archives/C01/p1690000000000100", data: "uploaded"},
+		{channel: "general", user: "U02", location: locationFile, link: "https://acme.slack.com/archives/C01/p1690000000000100", file: "env.txt", data: "SECRET=file\n"},
+	}, collect(t, reporter.Chunks))
+}
+
+func TestSource_InitRequiresToken(t *testing.T) {
+	anyConn, err := anypb.New(&sourcespb.Slack{})
+	require.NoError(t, err)
+	assert.Error(t, (&Source{}).Init(context.Background(), "test - slack", 0, 0, false, anyConn, 1))
+}
+
+func TestFormatTS(t *testing.T) {
+	assert.Equal(t, "2023-11-14T22:13:20Z", formatTS("1700000000.000100"))
+	assert.Equal(t, "", formatTS(""))
+	assert.Equal(t, "not-a-ts", formatTS("not-a-ts"))
+}
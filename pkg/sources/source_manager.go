@@ -10,6 +10,7 @@ import (
 
 	"github.com/marusama/semaphore/v2"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/common"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
@@ -32,6 +33,16 @@ type SourceManager struct {
 	useSourceUnitsFunc func() bool
 	// Downstream chunks channel to be scanned.
 	outputChunks chan *Chunk
+	// Optional on-disk persistence of job progress, written every
+	// checkpointInterval until Wait() returns.
+	checkpointer       *Checkpointer
+	checkpointInterval time.Duration
+	checkpointStop     chan struct{}
+	checkpointDone     chan struct{}
+	// Units whose chunks have been handed off but not yet scanned.
+	unitsScanning sync.WaitGroup
+	// Maps the chunks of those units to the function ChunkScanned calls.
+	chunksScanning sync.Map
 	// Set when Wait() returns.
 	firstErr chan error
 	waitErr  error
@@ -100,6 +111,19 @@ func WithConcurrentUnits(n int) func(*SourceManager) {
 	return func(mgr *SourceManager) { mgr.concurrentUnits = n }
 }
 
+// WithCheckpointer periodically persists the progress of every job to the
+// Checkpointer and restores previously saved progress into sources before
+// they run. An interval of 0 uses DefaultCheckpointInterval.
+func WithCheckpointer(checkpointer *Checkpointer, interval time.Duration) func(*SourceManager) {
+	return func(mgr *SourceManager) {
+		if interval <= 0 {
+			interval = DefaultCheckpointInterval
+		}
+		mgr.checkpointer = checkpointer
+		mgr.checkpointInterval = interval
+	}
+}
+
 // The default channel size for all the channels that are used to transport chunks.
 const defaultChannelSize = 64
 
@@ -116,9 +140,32 @@ func NewManager(opts ...func(*SourceManager)) *SourceManager {
 	for _, opt := range opts {
 		opt(&mgr)
 	}
+	if mgr.checkpointer != nil {
+		mgr.checkpointStop = make(chan struct{})
+		mgr.checkpointDone = make(chan struct{})
+		go mgr.checkpointLoop()
+	}
 	return &mgr
 }
 
+// checkpointLoop saves the checkpointer every checkpointInterval until
+// checkpointStop is closed.
+func (s *SourceManager) checkpointLoop() {
+	defer close(s.checkpointDone)
+	ticker := time.NewTicker(s.checkpointInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.checkpointer.Save(); err != nil {
+				context.Background().Logger().Error(err, "error saving checkpoint")
+			}
+		case <-s.checkpointStop:
+			return
+		}
+	}
+}
+
 func (s *SourceManager) GetIDs(ctx context.Context, sourceName string, kind sourcespb.SourceType) (SourceID, JobID, error) {
 	return s.api.GetIDs(ctx, sourceName, kind)
 }
@@ -127,6 +174,26 @@ func (s *SourceManager) GetIDs(ctx context.Context, sourceName string, kind sour
 // asynchronously runs it. Error information is stored and accessible via the
 // JobProgressRef as it becomes available.
 func (s *SourceManager) EnumerateAndScan(ctx context.Context, sourceName string, source Source, targets ...ChunkingTarget) (JobProgressRef, error) {
+	return s.enumerateAndScan(ctx, sourceName, source, "", targets...)
+}
+
+// EnumerateAndScanWithConfig is like EnumerateAndScan, but also ties the job's
+// checkpoint to conn, the connection the source was initialized with, and to
+// the source's options if it is a ConfigOptioner. A checkpoint saved for a
+// different configuration is not resumed and the job fails instead.
+func (s *SourceManager) EnumerateAndScanWithConfig(ctx context.Context, sourceName string, source Source, conn *anypb.Any) (JobProgressRef, error) {
+	configHash, err := hashConnection(conn, source)
+	if err != nil {
+		return JobProgressRef{
+			SourceName: sourceName,
+			SourceID:   source.SourceID(),
+			JobID:      source.JobID(),
+		}, err
+	}
+	return s.enumerateAndScan(ctx, sourceName, source, configHash)
+}
+
+func (s *SourceManager) enumerateAndScan(ctx context.Context, sourceName string, source Source, configHash string, targets ...ChunkingTarget) (JobProgressRef, error) {
 	sourceID, jobID := source.SourceID(), source.JobID()
 	// Do preflight checks before waiting on the pool.
 	if err := s.preflightChecks(ctx); err != nil {
@@ -159,11 +226,30 @@ func (s *SourceManager) EnumerateAndScan(ctx context.Context, sourceName string,
 		)
 		defer common.Recover(ctx)
 		defer cancel(nil)
-		if err := s.run(ctx, source, progress, targets...); err != nil {
+		// Targeted scans re-check specific data and are never resumed.
+		var checkpoint *checkpointJob
+		if len(targets) == 0 {
+			var err error
+			if checkpoint, err = s.checkpointer.track(sourceName, source, configHash); err != nil {
+				progress.ReportError(Fatal{err})
+				select {
+				case s.firstErr <- err:
+				default:
+				}
+				return
+			}
+		}
+		if err := s.run(ctx, source, progress, checkpoint, targets...); err != nil {
 			select {
 			case s.firstErr <- err:
 			default:
 			}
+			return
+		}
+		// A job that stopped because its context ended may not have
+		// scanned everything, so keep its checkpoint.
+		if ctx.Err() == nil {
+			checkpoint.finish()
 		}
 	}()
 	return progress.Ref(), nil
@@ -273,6 +359,15 @@ func (s *SourceManager) Wait() error {
 
 	// Return the first error returned by run.
 	s.wg.Wait()
+	if s.checkpointer != nil {
+		// Record the units still being scanned before the final save.
+		s.unitsScanning.Wait()
+		close(s.checkpointStop)
+		<-s.checkpointDone
+		if err := s.checkpointer.Save(); err != nil {
+			context.Background().Logger().Error(err, "error saving checkpoint")
+		}
+	}
 	select {
 	case s.waitErr = <-s.firstErr:
 	default:
@@ -287,6 +382,19 @@ func (s *SourceManager) Wait() error {
 	return s.waitErr
 }
 
+// ChunkScanned must be called by the consumer of Chunks() once it has detected
+// the secrets in chunk and notified their results. A manager with a
+// Checkpointer only records a unit as completed once all of its chunks have
+// been scanned.
+func (s *SourceManager) ChunkScanned(chunk *Chunk) {
+	if s.checkpointer == nil {
+		return
+	}
+	if done, ok := s.chunksScanning.LoadAndDelete(chunk); ok {
+		done.(func())()
+	}
+}
+
 // ScanChunk injects a chunk into the output stream of chunks to be scanned.
 // This method should rarely be used. TODO(THOG-1577): Remove when dependencies
 // no longer rely on this functionality.
@@ -333,7 +441,7 @@ func (s *SourceManager) preflightChecks(ctx context.Context) error {
 // run is a helper method to synchronously run the source. It does not check for
 // acquired resources. An error is returned if there was a fatal error during
 // the run. This information is also recorded in the JobProgress.
-func (s *SourceManager) run(ctx context.Context, source Source, report *JobProgress, targets ...ChunkingTarget) error {
+func (s *SourceManager) run(ctx context.Context, source Source, report *JobProgress, checkpoint *checkpointJob, targets ...ChunkingTarget) error {
 	report.Start(time.Now())
 	defer func() { report.End(time.Now()) }()
 
@@ -362,7 +470,7 @@ func (s *SourceManager) run(ctx context.Context, source Source, report *JobProgr
 	if enumChunker, ok := source.(SourceUnitEnumChunker); ok && canUseSourceUnits && s.useSourceUnitsFunc() {
 		ctx.Logger().Info("running source",
 			"with_units", true)
-		return s.runWithUnits(ctx, enumChunker, report)
+		return s.runWithUnits(ctx, enumChunker, report, checkpoint)
 	}
 	ctx.Logger().Info("running source",
 		"with_units", false,
@@ -509,7 +617,7 @@ func (s *SourceManager) runWithoutUnits(ctx context.Context, source Source, repo
 // runWithUnits is a helper method to run a Source that is also a
 // SourceUnitEnumChunker. This allows better introspection of what is getting
 // scanned and any errors encountered.
-func (s *SourceManager) runWithUnits(ctx context.Context, source SourceUnitEnumChunker, report *JobProgress) error {
+func (s *SourceManager) runWithUnits(ctx context.Context, source SourceUnitEnumChunker, report *JobProgress, checkpoint *checkpointJob) error {
 	unitReporter := &mgrUnitReporter{
 		unitCh: make(chan SourceUnit, 1),
 		report: report,
@@ -543,11 +651,22 @@ func (s *SourceManager) runWithUnits(ctx context.Context, source SourceUnitEnumC
 		unitPool.SetLimit(s.concurrentUnits)
 	}
 	for unit := range unitReporter.unitCh {
+		if checkpoint.unitCompleted(unit) {
+			id, kind := unit.SourceUnitID()
+			ctx.Logger().V(3).Info("skipping unit completed in a previous run", "unit_kind", kind, "unit", id)
+			continue
+		}
 		chunkReporter := &mgrChunkReporter{
 			unit:    unit,
 			chunkCh: make(chan *Chunk, defaultChannelSize),
 			report:  report,
 		}
+		// chunked is set before chunkCh is closed, so it is safe to read
+		// once the consumer below has drained the channel.
+		var chunked bool
+		// scanning counts the unit's chunks that the consumer of the
+		// output channel hasn't marked as scanned yet.
+		var scanning sync.WaitGroup
 		// Consume units and produce chunks.
 		unitPool.Go(func() error {
 			report.StartUnitChunking(unit, time.Now())
@@ -559,7 +678,9 @@ func (s *SourceManager) runWithUnits(ctx context.Context, source SourceUnitEnumC
 			if err := source.ChunkUnit(ctx, unit, chunkReporter); err != nil {
 				report.ReportError(Fatal{ChunkError{Unit: unit, Err: err}})
 				catchFirstFatal(Fatal{err})
+				return nil
 			}
+			chunked = ctx.Err() == nil
 			return nil
 		})
 		// Consume chunks and export chunks.
@@ -571,8 +692,24 @@ func (s *SourceManager) runWithUnits(ctx context.Context, source SourceUnitEnumC
 				if src, ok := source.(Source); ok {
 					chunk.JobID = src.JobID()
 				}
+				if checkpoint != nil {
+					scanning.Add(1)
+					s.chunksScanning.Store(chunk, scanning.Done)
+				}
 				s.outputChunks <- chunk
 			}
+			if !chunked || checkpoint == nil {
+				return
+			}
+			// Only record the unit once all of its chunks have been
+			// scanned, so a checkpoint never skips chunks that are still
+			// buffered or being scanned.
+			s.unitsScanning.Add(1)
+			go func() {
+				defer s.unitsScanning.Done()
+				scanning.Wait()
+				checkpoint.completeUnit(unit)
+			}()
 		}()
 	}
 	wg.Wait()
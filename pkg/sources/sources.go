@@ -93,6 +93,14 @@ type SourceUnitUnmarshaller interface {
 	UnmarshalSourceUnit(data []byte) (SourceUnit, error)
 }
 
+// ConfigOptioner defines an optional interface a Source can implement to
+// report options that change what it scans but are not part of its
+// connection. They are hashed together with the connection, so a checkpoint
+// is only resumed by a scan with the same options.
+type ConfigOptioner interface {
+	ConfigOptions() []string
+}
+
 // SourceUnitEnumerator defines an optional interface a Source can implement to
 // support enumerating an initialized Source into SourceUnits.
 type SourceUnitEnumerator interface {
@@ -144,6 +152,11 @@ func (c *ConfiguredSource) SourceType() sourcespb.SourceType {
 	return c.source.Type()
 }
 
+// Connection exposes the connection the source is initialized with.
+func (c *ConfiguredSource) Connection() *anypb.Any {
+	return c.initParams.conn
+}
+
 // Init returns the initialized Source. The ConfiguredSource is unusable after
 // calling this method because initializing a [Source] more than once is undefined.
 func (c *ConfiguredSource) Init(ctx context.Context, sourceID SourceID, jobID JobID) (Source, error) {
@@ -220,6 +233,96 @@ type SourceUnit interface {
 	Display() string
 }
 
+// BitbucketConfig defines the optional configuration for a Bitbucket source.
+type BitbucketConfig struct {
+	// Endpoint is the endpoint of the source. Leave empty for bitbucket.org.
+	Endpoint string
+	// Token is the access token to use to authenticate with the source.
+	Token string
+	// Username and Password are used for basic authentication (e.g. app passwords).
+	Username,
+	Password string
+	// Repos is the list of repositories to scan.
+	Repos []string
+	// IgnoreRepos is a list of repository name globs to exclude from the scan.
+	IgnoreRepos []string
+	// InstallationType is the kind of Bitbucket installation: "cloud",
+	// "data-center", or empty to detect it from the endpoint.
+	InstallationType string
+	// Filter is the filter to use to scan the source.
+	Filter *common.Filter
+	// SkipBinaries allows skipping binary files from the scan.
+	SkipBinaries bool
+}
+
+// ConfluenceConfig defines the optional configuration for a Confluence source.
+type ConfluenceConfig struct {
+	// Endpoint is the base URL of the Confluence instance,
+	// e.g. https://example.atlassian.net/wiki.
+	Endpoint string
+	// Username and Password are used for basic authentication. On Confluence
+	// Cloud the password is an API token.
+	Username,
+	Password string
+	// Token is a personal access token, sent as a bearer token.
+	Token string
+	// Spaces is the list of space keys to scan. All spaces are scanned if empty.
+	Spaces []string
+	// IgnoreSpaces is a list of space keys to exclude from the scan.
+	IgnoreSpaces []string
+	// SpacesScope limits which spaces are listed: "all", "global" or "personal".
+	SpacesScope string
+	// IncludeAttachments enables scanning page attachments.
+	IncludeAttachments bool
+	// SkipHistory disables scanning previous versions of pages.
+	SkipHistory bool
+	// InsecureSkipVerifyTLS disables TLS certificate verification.
+	InsecureSkipVerifyTLS bool
+}
+
+// JiraConfig defines the optional configuration for a Jira source.
+type JiraConfig struct {
+	// Endpoint is the base URL of the Jira instance,
+	// e.g. https://example.atlassian.net.
+	Endpoint string
+	// Username and Password are used for basic authentication. On Jira Cloud
+	// the password is an API token.
+	Username,
+	Password string
+	// Token is a personal access token, sent as a bearer token.
+	Token string
+	// Projects is the list of project keys to scan. All projects are scanned if empty.
+	Projects []string
+	// IgnoreProjects is a list of project keys to exclude from the scan.
+	IgnoreProjects []string
+	// JQL further restricts the issues that are scanned.
+	JQL string
+	// Since restricts the scan to issues updated at or after this time. It is
+	// a date (2006-01-02), an RFC 3339 timestamp, or a duration before the
+	// start of the scan (e.g. 720h).
+	Since string
+	// InsecureSkipVerifyTLS disables TLS certificate verification.
+	InsecureSkipVerifyTLS bool
+}
+
+// SlackConfig defines the optional configuration for a Slack source.
+type SlackConfig struct {
+	// Token is a Slack bot or user token used in API mode.
+	Token string
+	// Endpoint is the Slack Web API URL. Leave empty for https://slack.com/api.
+	Endpoint string
+	// Channels is the list of channel names or IDs to scan. All channels are
+	// scanned if empty.
+	Channels []string
+	// IgnoreChannels is a list of channel names or IDs to exclude from the scan.
+	IgnoreChannels []string
+	// ExportPath is the path to a workspace export zip or extracted directory.
+	// If set, the export is scanned instead of calling the API.
+	ExportPath string
+	// WorkspaceURL is used to build links to messages, e.g. https://acme.slack.com.
+	WorkspaceURL string
+}
+
 // DockerConfig defines the optional configuration for a Docker source.
 type DockerConfig struct {
 	// Images is the list of images to scan.
@@ -280,6 +383,28 @@ type GitConfig struct {
 	ExcludeGlobs string
 	// SkipBinaries allows skipping binary files from the scan.
 	SkipBinaries bool
+	// Revisions, if set, are `git log` revision arguments that select the
+	// commits to scan in place of HeadRef and BaseRef.
+	Revisions []string
+	// Ranges, if set, are `git rev-list` ranges like "main..feature" whose
+	// commits are scanned in place of HeadRef and BaseRef. Commits shared
+	// between ranges are scanned once.
+	Ranges []string
+	// StagedOnly limits the scan to the staged changes of a local repository.
+	StagedOnly bool
+	// IncludeTags additionally scans the messages of annotated tags.
+	IncludeTags bool
+	// IncludeStashes additionally scans stash entries.
+	IncludeStashes bool
+	// IncludeReflog additionally scans commits only reachable from reflogs.
+	IncludeReflog bool
+	// IncludeDangling additionally scans unreachable commits, blobs and tags.
+	IncludeDangling bool
+	// StateFile, if set, is the path to a file recording the last scanned
+	// commit of each repository and ref. Only commits added since are
+	// scanned, and the file is updated once the engine has finished a scan
+	// without errors.
+	StateFile string
 }
 
 // GithubConfig defines the optional configuration for a github source.
@@ -316,6 +441,18 @@ type GithubConfig struct {
 	IncludeWikis bool
 	// CommentsTimeframeDays indicates how many days of comments to include in the scan.
 	CommentsTimeframeDays uint32
+	// IncludeActionsLogs indicates whether to include GitHub Actions workflow run logs in the scan.
+	IncludeActionsLogs bool
+	// IncludeActionsArtifacts indicates whether to include GitHub Actions workflow run artifacts in the scan.
+	IncludeActionsArtifacts bool
+	// ActionsTimeframeDays indicates how many days of workflow runs to include in the scan.
+	ActionsTimeframeDays uint32
+	// MaxActionsDownloadSize is the maximum size of workflow run log archives and artifacts to scan.
+	MaxActionsDownloadSize int64
+	// IncludeReleases indicates whether to include release notes and assets in the scan.
+	IncludeReleases bool
+	// MaxReleaseAssetSize is the maximum release asset size to scan.
+	MaxReleaseAssetSize int64
 	// AuthInUrl determines wether to use authentication token in repository url or in header.
 	AuthInUrl bool
 }
@@ -354,6 +491,24 @@ type GitlabConfig struct {
 	ExcludeRepos []string
 	// AuthInUrl determines wether to use authentication token in repository url or in header.
 	AuthInUrl bool
+	// IncludeIssueComments indicates whether to include issue descriptions and comments in the scan.
+	IncludeIssueComments bool
+	// IncludeMergeRequestComments indicates whether to include merge request descriptions and discussions in the scan.
+	IncludeMergeRequestComments bool
+	// IncludeSnippets indicates whether to include personal and project snippets in the scan.
+	IncludeSnippets bool
+	// IncludeWikis indicates whether to include project wikis in the scan.
+	IncludeWikis bool
+	// CommentsTimeframeDays indicates how many days of issues and merge requests to include in the scan.
+	CommentsTimeframeDays uint32
+	// IncludePipelineLogs indicates whether to include CI/CD job logs in the scan.
+	IncludePipelineLogs bool
+	// IncludePipelineArtifacts indicates whether to include CI/CD job artifacts in the scan.
+	IncludePipelineArtifacts bool
+	// PipelinesTimeframeDays indicates how many days of CI/CD pipelines to include in the scan.
+	PipelinesTimeframeDays uint32
+	// MaxPipelineDownloadSize is the maximum size of job traces and artifacts archives to scan.
+	MaxPipelineDownloadSize int64
 }
 
 // FilesystemConfig defines the optional configuration for a filesystem source.
@@ -364,6 +519,13 @@ type FilesystemConfig struct {
 	IncludePathsFile string
 	// ExcludePathsFile is the path to a file containing a list of regexps to exclude from the scan.
 	ExcludePathsFile string
+	// UseIgnoreFiles skips the paths ignored by .gitignore and .trufflehogignore files
+	// found in the scanned directories.
+	UseIgnoreFiles bool
+	// FollowSymlinks scans the targets of symlinks, scanning each directory once.
+	FollowSymlinks bool
+	// ConfineSymlinks only follows symlinks whose target is inside the scanned path.
+	ConfineSymlinks bool
 }
 
 // S3Config defines the optional configuration for an S3 source.
@@ -516,6 +678,38 @@ func (p *Progress) GetProgress() *Progress {
 	return p
 }
 
+// checkpoint returns the resumable state of the Progress. It is safe to call
+// on a nil Progress.
+func (p *Progress) checkpoint() jobCheckpoint {
+	if p == nil {
+		return jobCheckpoint{}
+	}
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	return jobCheckpoint{
+		Message:           p.Message,
+		EncodedResumeInfo: p.EncodedResumeInfo,
+		SectionsCompleted: p.SectionsCompleted,
+		SectionsRemaining: p.SectionsRemaining,
+	}
+}
+
+// restore sets the Progress to a previously saved checkpoint so the source
+// resumes from it. It is safe to call on a nil Progress.
+func (p *Progress) restore(checkpoint jobCheckpoint) {
+	if p == nil {
+		return
+	}
+	p.mut.Lock()
+	defer p.mut.Unlock()
+	p.Message = checkpoint.Message
+	p.EncodedResumeInfo = checkpoint.EncodedResumeInfo
+	p.SectionsCompleted = checkpoint.SectionsCompleted
+	p.SectionsRemaining = checkpoint.SectionsRemaining
+	// Force the sub-unit resume map to be rebuilt from EncodedResumeInfo.
+	p.encodedResumeInfoByID = nil
+}
+
 // -sub-unit-resumption------------------------------------------------------------
 //
 // The following collection of methods are intended to provide a thread-safe
@@ -1,7 +1,14 @@
 package sourcestest
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/trufflesecurity/trufflehog/v3/pkg/context"
 	"github.com/trufflesecurity/trufflehog/v3/pkg/sources"
@@ -59,3 +66,20 @@ func (ErrReporter) ChunkOk(context.Context, sources.Chunk) error {
 func (ErrReporter) ChunkErr(context.Context, error) error {
 	return fmt.Errorf("ErrReporter: ChunkErr error")
 }
+
+// InitSource initializes source with conn as its connection and returns it,
+// failing the test if either step fails.
+func InitSource[S sources.Source](t testing.TB, source S, name string, conn proto.Message) S {
+	t.Helper()
+	anyConn, err := anypb.New(conn)
+	require.NoError(t, err)
+	require.NoError(t, source.Init(context.Background(), name, 0, 0, false, anyConn, 1))
+	return source
+}
+
+// WriteJSON writes v to w as the JSON body of a test server response.
+func WriteJSON(t testing.TB, w http.ResponseWriter, v any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}